@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost-server/app"
 	"github.com/mattermost/mattermost-server/model"
@@ -120,6 +121,35 @@ func TestCreatePost(t *testing.T) {
 	}
 }
 
+func TestCreatePostIntegrationProps(t *testing.T) {
+	th := Setup().InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	props := model.StringInterface{
+		model.POST_PROPS_FROM_WEBHOOK:      "true",
+		model.POST_PROPS_OVERRIDE_USERNAME: "impersonated",
+		model.POST_PROPS_OVERRIDE_ICON_URL: "http://example.com/icon.png",
+		"attachments":                      "good",
+	}
+
+	post := &model.Post{ChannelId: th.BasicChannel.Id, Message: "a" + model.NewId() + "a", Props: props}
+	rpost, resp := Client.CreatePost(post)
+	CheckNoError(t, resp)
+
+	assert.Nil(t, rpost.Props[model.POST_PROPS_FROM_WEBHOOK])
+	assert.Nil(t, rpost.Props[model.POST_PROPS_OVERRIDE_USERNAME])
+	assert.Nil(t, rpost.Props[model.POST_PROPS_OVERRIDE_ICON_URL])
+	assert.Equal(t, "good", rpost.Props["attachments"])
+
+	post = &model.Post{ChannelId: th.BasicChannel.Id, Message: "a" + model.NewId() + "a", Props: props}
+	rpost, resp = th.SystemAdminClient.CreatePost(post)
+	CheckNoError(t, resp)
+
+	assert.Equal(t, "true", rpost.Props[model.POST_PROPS_FROM_WEBHOOK])
+	assert.Equal(t, "impersonated", rpost.Props[model.POST_PROPS_OVERRIDE_USERNAME])
+}
+
 func TestCreatePostEphemeral(t *testing.T) {
 	th := Setup().InitBasic()
 	defer th.TearDown()
@@ -625,6 +655,57 @@ func TestUpdateOthersPostInDirectMessageChannel(t *testing.T) {
 	CheckNoError(t, resp)
 }
 
+func TestUpdatePostIntegrationProps(t *testing.T) {
+	th := Setup().InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	rpost, resp := Client.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "a" + model.NewId() + "a"})
+	CheckNoError(t, resp)
+
+	rpost.Props = model.StringInterface{
+		model.POST_PROPS_FROM_WEBHOOK:      "true",
+		model.POST_PROPS_OVERRIDE_USERNAME: "impersonated",
+		model.POST_PROPS_OVERRIDE_ICON_URL: "http://example.com/icon.png",
+		"attachments":                      "good",
+	}
+	rupost, resp := Client.UpdatePost(rpost.Id, rpost)
+	CheckNoError(t, resp)
+
+	assert.Nil(t, rupost.Props[model.POST_PROPS_FROM_WEBHOOK])
+	assert.Nil(t, rupost.Props[model.POST_PROPS_OVERRIDE_USERNAME])
+	assert.Nil(t, rupost.Props[model.POST_PROPS_OVERRIDE_ICON_URL])
+	assert.Equal(t, "good", rupost.Props["attachments"])
+
+	// Editing a post made on behalf of an integration keeps its integration props.
+	webhookPost, err := th.App.CreatePost(&model.Post{
+		UserId:    th.BasicUser.Id,
+		ChannelId: th.BasicChannel.Id,
+		Message:   "a" + model.NewId() + "a",
+		Props: model.StringInterface{
+			model.POST_PROPS_FROM_WEBHOOK:      "true",
+			model.POST_PROPS_OVERRIDE_USERNAME: "webhook",
+		},
+	}, th.BasicChannel, false)
+	require.Nil(t, err)
+
+	webhookPost.Props = model.StringInterface{model.POST_PROPS_OVERRIDE_USERNAME: "impersonated"}
+	rupost, resp = Client.UpdatePost(webhookPost.Id, webhookPost)
+	CheckNoError(t, resp)
+
+	assert.Equal(t, "true", rupost.Props[model.POST_PROPS_FROM_WEBHOOK])
+	assert.Equal(t, "webhook", rupost.Props[model.POST_PROPS_OVERRIDE_USERNAME])
+
+	rpost, resp = th.SystemAdminClient.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "a" + model.NewId() + "a"})
+	CheckNoError(t, resp)
+
+	rpost.Props = model.StringInterface{model.POST_PROPS_FROM_WEBHOOK: "true"}
+	rupost, resp = th.SystemAdminClient.UpdatePost(rpost.Id, rpost)
+	CheckNoError(t, resp)
+
+	assert.Equal(t, "true", rupost.Props[model.POST_PROPS_FROM_WEBHOOK])
+}
+
 func TestPatchPost(t *testing.T) {
 	th := Setup().InitBasic()
 	defer th.TearDown()
@@ -713,6 +794,58 @@ func TestPatchPost(t *testing.T) {
 	CheckNoError(t, resp)
 }
 
+func TestPatchPostIntegrationProps(t *testing.T) {
+	th := Setup().InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	rpost, resp := Client.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "a" + model.NewId() + "a"})
+	CheckNoError(t, resp)
+
+	patch := &model.PostPatch{
+		Props: &model.StringInterface{
+			model.POST_PROPS_FROM_WEBHOOK:      "true",
+			model.POST_PROPS_OVERRIDE_USERNAME: "impersonated",
+			model.POST_PROPS_OVERRIDE_ICON_URL: "http://example.com/icon.png",
+			"attachments":                      "good",
+		},
+	}
+	rpatched, resp := Client.PatchPost(rpost.Id, patch)
+	CheckNoError(t, resp)
+
+	assert.Nil(t, rpatched.Props[model.POST_PROPS_FROM_WEBHOOK])
+	assert.Nil(t, rpatched.Props[model.POST_PROPS_OVERRIDE_USERNAME])
+	assert.Nil(t, rpatched.Props[model.POST_PROPS_OVERRIDE_ICON_URL])
+	assert.Equal(t, "good", rpatched.Props["attachments"])
+
+	// Patching a post made on behalf of an integration keeps its integration props.
+	webhookPost, err := th.App.CreatePost(&model.Post{
+		UserId:    th.BasicUser.Id,
+		ChannelId: th.BasicChannel.Id,
+		Message:   "a" + model.NewId() + "a",
+		Props: model.StringInterface{
+			model.POST_PROPS_FROM_WEBHOOK:      "true",
+			model.POST_PROPS_OVERRIDE_USERNAME: "webhook",
+		},
+	}, th.BasicChannel, false)
+	require.Nil(t, err)
+
+	rpatched, resp = Client.PatchPost(webhookPost.Id, &model.PostPatch{Props: &model.StringInterface{"attachments": "good"}})
+	CheckNoError(t, resp)
+
+	assert.Equal(t, "true", rpatched.Props[model.POST_PROPS_FROM_WEBHOOK])
+	assert.Equal(t, "webhook", rpatched.Props[model.POST_PROPS_OVERRIDE_USERNAME])
+	assert.Equal(t, "good", rpatched.Props["attachments"])
+
+	rpost, resp = th.SystemAdminClient.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "a" + model.NewId() + "a"})
+	CheckNoError(t, resp)
+
+	rpatched, resp = th.SystemAdminClient.PatchPost(rpost.Id, &model.PostPatch{Props: &model.StringInterface{model.POST_PROPS_FROM_WEBHOOK: "true"}})
+	CheckNoError(t, resp)
+
+	assert.Equal(t, "true", rpatched.Props[model.POST_PROPS_FROM_WEBHOOK])
+}
+
 func TestPinPost(t *testing.T) {
 	th := Setup().InitBasic()
 	defer th.TearDown()
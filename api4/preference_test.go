@@ -19,7 +19,7 @@ func TestGetPreferences(t *testing.T) {
 	th.LoginBasic()
 	user1 := th.BasicUser
 
-	category := model.NewId()
+	category := model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId()
 	preferences1 := model.Preferences{
 		{
 			UserId:   user1.Id,
@@ -33,7 +33,7 @@ func TestGetPreferences(t *testing.T) {
 		},
 		{
 			UserId:   user1.Id,
-			Category: model.NewId(),
+			Category: model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId(),
 			Name:     model.NewId(),
 		},
 	}
@@ -77,7 +77,7 @@ func TestGetPreferencesByCategory(t *testing.T) {
 	th.LoginBasic()
 	user1 := th.BasicUser
 
-	category := model.NewId()
+	category := model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId()
 	preferences1 := model.Preferences{
 		{
 			UserId:   user1.Id,
@@ -91,7 +91,7 @@ func TestGetPreferencesByCategory(t *testing.T) {
 		},
 		{
 			UserId:   user1.Id,
-			Category: model.NewId(),
+			Category: model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId(),
 			Name:     model.NewId(),
 		},
 	}
@@ -191,7 +191,7 @@ func TestUpdatePreferences(t *testing.T) {
 	th.LoginBasic()
 	user1 := th.BasicUser
 
-	category := model.NewId()
+	category := model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId()
 	preferences1 := model.Preferences{
 		{
 			UserId:   user1.Id,
@@ -205,7 +205,7 @@ func TestUpdatePreferences(t *testing.T) {
 		},
 		{
 			UserId:   user1.Id,
-			Category: model.NewId(),
+			Category: model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId(),
 			Name:     model.NewId(),
 		},
 	}
@@ -261,12 +261,12 @@ func TestUpdatePreferencesWebsocket(t *testing.T) {
 	preferences := &model.Preferences{
 		{
 			UserId:   userId,
-			Category: model.NewId(),
+			Category: model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId(),
 			Name:     model.NewId(),
 		},
 		{
 			UserId:   userId,
-			Category: model.NewId(),
+			Category: model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId(),
 			Name:     model.NewId(),
 		},
 	}
@@ -357,12 +357,12 @@ func TestDeletePreferencesWebsocket(t *testing.T) {
 	preferences := &model.Preferences{
 		{
 			UserId:   userId,
-			Category: model.NewId(),
+			Category: model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId(),
 			Name:     model.NewId(),
 		},
 		{
 			UserId:   userId,
-			Category: model.NewId(),
+			Category: model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId(),
 			Name:     model.NewId(),
 		},
 	}
@@ -741,18 +741,18 @@ func TestGetChannelMembersTimezones(t *testing.T) {
 
 	user := th.BasicUser
 	user.Timezone["useAutomaticTimezone"] = "false"
-	user.Timezone["manualTimezone"] = "XOXO/BLABLA"
+	user.Timezone["manualTimezone"] = "America/New_York"
 	th.App.UpdateUser(user, false)
 
 	user2 := th.BasicUser2
-	user2.Timezone["automaticTimezone"] = "NoWhere/Island"
+	user2.Timezone["automaticTimezone"] = "Europe/Paris"
 	th.App.UpdateUser(user2, false)
 
 	user3 := model.User{Email: strings.ToLower(model.NewId()) + "success+test@example.com", Nickname: "Darth Vader", Username: "vader" + model.NewId(), Password: "passwd1", AuthService: ""}
 	ruser, _ := th.App.CreateUser(&user3)
 	th.App.AddUserToChannel(ruser, th.BasicChannel)
 
-	ruser.Timezone["automaticTimezone"] = "NoWhere/Island"
+	ruser.Timezone["automaticTimezone"] = "Europe/Paris"
 	th.App.UpdateUser(ruser, false)
 
 	user4 := model.User{Email: strings.ToLower(model.NewId()) + "success+test@example.com", Nickname: "Darth Vader", Username: "vader" + model.NewId(), Password: "passwd1", AuthService: ""}
@@ -354,33 +354,43 @@ func (a *App) CreateCommand(cmd *model.Command) (*model.Command, *model.AppError
 		return nil, model.NewAppError("CreateCommand", "api.command.disabled.app_error", nil, "", http.StatusNotImplemented)
 	}
 
-	cmd.Trigger = strings.ToLower(cmd.Trigger)
+	cmd.Trigger = model.NormalizeCommandTrigger(cmd.Trigger)
 
-	result := <-a.Srv.Store.Command().GetByTeam(cmd.TeamId)
+	if err := a.checkCommandTriggerAvailable("CreateCommand", cmd); err != nil {
+		return nil, err
+	}
+
+	result := <-a.Srv.Store.Command().Save(cmd)
 	if result.Err != nil {
 		return nil, result.Err
 	}
 
+	return result.Data.(*model.Command), nil
+}
+
+// checkCommandTriggerAvailable returns an error if the command's trigger is already used by a
+// built-in command or by another command on the same team.
+func (a *App) checkCommandTriggerAvailable(where string, cmd *model.Command) *model.AppError {
+	result := <-a.Srv.Store.Command().GetByTeam(cmd.TeamId)
+	if result.Err != nil {
+		return result.Err
+	}
+
 	teamCmds := result.Data.([]*model.Command)
 	for _, existingCommand := range teamCmds {
-		if cmd.Trigger == existingCommand.Trigger {
-			return nil, model.NewAppError("CreateCommand", "api.command.duplicate_trigger.app_error", nil, "", http.StatusBadRequest)
+		if cmd.Trigger == existingCommand.Trigger && cmd.Id != existingCommand.Id {
+			return model.NewAppError(where, "api.command.duplicate_trigger.app_error", nil, "", http.StatusBadRequest)
 		}
 	}
 
 	for _, builtInProvider := range commandProviders {
 		builtInCommand := builtInProvider.GetCommand(a, utils.T)
 		if builtInCommand != nil && cmd.Trigger == builtInCommand.Trigger {
-			return nil, model.NewAppError("CreateCommand", "api.command.duplicate_trigger.app_error", nil, "", http.StatusBadRequest)
+			return model.NewAppError(where, "api.command.duplicate_trigger.app_error", nil, "", http.StatusBadRequest)
 		}
 	}
 
-	result = <-a.Srv.Store.Command().Save(cmd)
-	if result.Err != nil {
-		return nil, result.Err
-	}
-
-	return result.Data.(*model.Command), nil
+	return nil
 }
 
 func (a *App) GetCommand(commandId string) (*model.Command, *model.AppError) {
@@ -402,7 +412,7 @@ func (a *App) UpdateCommand(oldCmd, updatedCmd *model.Command) (*model.Command,
 		return nil, model.NewAppError("UpdateCommand", "api.command.disabled.app_error", nil, "", http.StatusNotImplemented)
 	}
 
-	updatedCmd.Trigger = strings.ToLower(updatedCmd.Trigger)
+	updatedCmd.Trigger = model.NormalizeCommandTrigger(updatedCmd.Trigger)
 	updatedCmd.Id = oldCmd.Id
 	updatedCmd.Token = oldCmd.Token
 	updatedCmd.CreateAt = oldCmd.CreateAt
@@ -411,6 +421,12 @@ func (a *App) UpdateCommand(oldCmd, updatedCmd *model.Command) (*model.Command,
 	updatedCmd.CreatorId = oldCmd.CreatorId
 	updatedCmd.TeamId = oldCmd.TeamId
 
+	if updatedCmd.Trigger != oldCmd.Trigger {
+		if err := a.checkCommandTriggerAvailable("UpdateCommand", updatedCmd); err != nil {
+			return nil, err
+		}
+	}
+
 	result := <-a.Srv.Store.Command().Update(updatedCmd)
 	if result.Err != nil {
 		return nil, result.Err
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost-server/model"
 )
@@ -46,6 +47,61 @@ func TestMoveCommand(t *testing.T) {
 	assert.EqualValues(t, targetTeam.Id, retrievedCommand.TeamId)
 }
 
+func TestCommandTriggerNormalization(t *testing.T) {
+	th := Setup().InitBasic()
+	defer th.TearDown()
+
+	enableCommands := *th.App.Config().ServiceSettings.EnableCommands
+	defer func() {
+		th.App.UpdateConfig(func(cfg *model.Config) { cfg.ServiceSettings.EnableCommands = &enableCommands })
+	}()
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableCommands = true })
+
+	newCommand := func(trigger string) *model.Command {
+		return &model.Command{
+			CreatorId: th.BasicUser.Id,
+			TeamId:    th.BasicTeam.Id,
+			URL:       "http://nowhere.com/",
+			Method:    model.COMMAND_METHOD_POST,
+			Trigger:   trigger,
+		}
+	}
+
+	deploy, err := th.App.CreateCommand(newCommand("/deploy"))
+	require.Nil(t, err)
+	assert.Equal(t, "deploy", deploy.Trigger)
+
+	// Creating the same trigger with or without a slash conflicts.
+	_, err = th.App.CreateCommand(newCommand("deploy"))
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "api.command.duplicate_trigger.app_error", err.Id)
+	}
+	_, err = th.App.CreateCommand(newCommand("/Deploy"))
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "api.command.duplicate_trigger.app_error", err.Id)
+	}
+
+	other, err := th.App.CreateCommand(newCommand("other"))
+	require.Nil(t, err)
+
+	// Updating another command to the same trigger, with or without a slash, conflicts too.
+	for _, trigger := range []string{"deploy", "/deploy"} {
+		_, err = th.App.UpdateCommand(other, newCommand(trigger))
+		if assert.NotNil(t, err, trigger) {
+			assert.Equal(t, "api.command.duplicate_trigger.app_error", err.Id)
+		}
+	}
+
+	// Updating a command keeps its own trigger valid and normalizes a new one.
+	updated, err := th.App.UpdateCommand(deploy, newCommand("/deploy"))
+	require.Nil(t, err)
+	assert.Equal(t, "deploy", updated.Trigger)
+
+	updated, err = th.App.UpdateCommand(other, newCommand("/Release"))
+	require.Nil(t, err)
+	assert.Equal(t, "release", updated.Trigger)
+}
+
 func TestCreateCommandPost(t *testing.T) {
 	th := Setup().InitBasic()
 	defer th.TearDown()
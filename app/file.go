@@ -422,7 +422,8 @@ func (a *App) DoUploadFile(now time.Time, rawTeamId string, rawChannelId string,
 }
 
 func (a *App) DoUploadFileExpectModification(now time.Time, rawTeamId string, rawChannelId string, rawUserId string, rawFilename string, data []byte) (*model.FileInfo, []byte, *model.AppError) {
-	filename := filepath.Base(rawFilename)
+	// Some browsers send the full path of the file on Windows, which FileInfo.IsValid rejects.
+	filename := filepath.Base(strings.Replace(rawFilename, "\\", "/", -1))
 	teamId := filepath.Base(rawTeamId)
 	channelId := filepath.Base(rawChannelId)
 	userId := filepath.Base(rawUserId)
@@ -40,6 +40,28 @@ func TestGeneratePublicLinkHash(t *testing.T) {
 	}
 }
 
+func TestDoUploadFileWindowsPath(t *testing.T) {
+	th := Setup()
+	defer th.TearDown()
+
+	teamId := model.NewId()
+	channelId := model.NewId()
+	userId := model.NewId()
+
+	info, err := th.App.DoUploadFile(time.Now(), teamId, channelId, userId, "C:\\Users\\test\\file.txt", []byte("abcd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		<-th.App.Srv.Store.FileInfo().PermanentDelete(info.Id)
+		th.App.RemoveFile(info.Path)
+	}()
+
+	if info.Name != "file.txt" {
+		t.Fatal("should have stripped the directories from the name", info.Name)
+	}
+}
+
 func TestDoUploadFile(t *testing.T) {
 	th := Setup()
 	defer th.TearDown()
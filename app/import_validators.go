@@ -87,15 +87,7 @@ func validateRoleImportData(data *RoleImportData) *model.AppError {
 
 	if data.Permissions != nil {
 		for _, permission := range *data.Permissions {
-			permissionValidated := false
-			for _, p := range model.ALL_PERMISSIONS {
-				if permission == p.Id {
-					permissionValidated = true
-					break
-				}
-			}
-
-			if !permissionValidated {
+			if _, ok := model.PermissionById(permission); !ok {
 				return model.NewAppError("BulkImport", "app.import.validate_role_import_data.invalid_permission.error", nil, "permission"+permission, http.StatusBadRequest)
 			}
 		}
@@ -376,9 +376,7 @@ func (a *App) GetUserAccessTokens(page, perPage int) ([]*model.UserAccessToken,
 		return nil, result.Err
 	}
 	tokens := result.Data.([]*model.UserAccessToken)
-	for _, token := range tokens {
-		token.Token = ""
-	}
+	model.UserAccessTokenList(tokens).Sanitize()
 
 	return tokens, nil
 
@@ -390,9 +388,7 @@ func (a *App) GetUserAccessTokensForUser(userId string, page, perPage int) ([]*m
 		return nil, result.Err
 	}
 	tokens := result.Data.([]*model.UserAccessToken)
-	for _, token := range tokens {
-		token.Token = ""
-	}
+	model.UserAccessTokenList(tokens).Sanitize()
 
 	return tokens, nil
 
@@ -405,7 +401,7 @@ func (a *App) GetUserAccessToken(tokenId string, sanitize bool) (*model.UserAcce
 	}
 	token := result.Data.(*model.UserAccessToken)
 	if sanitize {
-		token.Token = ""
+		token.Sanitize()
 	}
 	return token, nil
 
@@ -417,9 +413,7 @@ func (a *App) SearchUserAccessTokens(term string) ([]*model.UserAccessToken, *mo
 		return nil, result.Err
 	}
 	tokens := result.Data.([]*model.UserAccessToken)
-	for _, token := range tokens {
-		token.Token = ""
-	}
+	model.UserAccessTokenList(tokens).Sanitize()
 	return tokens, nil
 
 }
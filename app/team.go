@@ -22,6 +22,10 @@ import (
 )
 
 func (a *App) CreateTeam(team *model.Team) (*model.Team, *model.AppError) {
+	if err := model.IsValidTeamAllowedDomains(team.AllowedDomains); err != nil {
+		return nil, err
+	}
+
 	result := <-a.Srv.Store.Team().Save(team)
 	if result.Err != nil {
 		return nil, result.Err
@@ -103,6 +107,14 @@ func (a *App) UpdateTeam(team *model.Team) (*model.Team, *model.AppError) {
 		return nil, err
 	}
 
+	// Teams saved before allowed domains were validated may hold entries that are no longer
+	// accepted, so they are only checked when they change.
+	if team.AllowedDomains != oldTeam.AllowedDomains {
+		if err = model.IsValidTeamAllowedDomains(team.AllowedDomains); err != nil {
+			return nil, err
+		}
+	}
+
 	validDomains := a.normalizeDomains(a.Config().TeamSettings.RestrictCreationToDomains)
 	if len(validDomains) > 0 {
 		for _, domain := range a.normalizeDomains(team.AllowedDomains) {
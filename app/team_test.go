@@ -36,6 +36,29 @@ func TestCreateTeam(t *testing.T) {
 	}
 }
 
+func TestCreateTeamAllowedDomains(t *testing.T) {
+	th := Setup().InitBasic()
+	defer th.TearDown()
+
+	id := model.NewId()
+	team := &model.Team{
+		DisplayName:    "dn_" + id,
+		Name:           "name" + id,
+		Email:          "success+" + id + "@simulator.amazonses.com",
+		Type:           model.TEAM_OPEN,
+		AllowedDomains: "example.com, http://example.org",
+	}
+
+	_, err := th.App.CreateTeam(team)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "model.team.is_valid.allowed_domain.app_error", err.Id)
+	}
+
+	team.AllowedDomains = "example.com, example.org"
+	_, err = th.App.CreateTeam(team)
+	assert.Nil(t, err)
+}
+
 func TestCreateTeamWithUser(t *testing.T) {
 	th := Setup().InitBasic()
 	defer th.TearDown()
@@ -73,6 +96,33 @@ func TestUpdateTeam(t *testing.T) {
 	}
 }
 
+func TestUpdateTeamAllowedDomains(t *testing.T) {
+	th := Setup().InitBasic()
+	defer th.TearDown()
+
+	// Simulate a team saved before allowed domains were validated.
+	th.BasicTeam.AllowedDomains = "@example.com, bad_domain.com"
+	result := <-th.App.Srv.Store.Team().Update(th.BasicTeam)
+	require.Nil(t, result.Err)
+
+	th.BasicTeam.DisplayName = "Testing 123"
+	updatedTeam, err := th.App.UpdateTeam(th.BasicTeam)
+	require.Nil(t, err)
+	assert.Equal(t, "Testing 123", updatedTeam.DisplayName)
+	assert.Equal(t, "@example.com, bad_domain.com", updatedTeam.AllowedDomains)
+
+	th.BasicTeam.AllowedDomains = "http://example.com"
+	_, err = th.App.UpdateTeam(th.BasicTeam)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "model.team.is_valid.allowed_domain.app_error", err.Id)
+	}
+
+	th.BasicTeam.AllowedDomains = "example.com"
+	updatedTeam, err = th.App.UpdateTeam(th.BasicTeam)
+	require.Nil(t, err)
+	assert.Equal(t, "example.com", updatedTeam.AllowedDomains)
+}
+
 func TestAddUserToTeam(t *testing.T) {
 	th := Setup().InitBasic()
 	defer th.TearDown()
@@ -18,8 +18,8 @@ import (
 )
 
 const (
-	TRIGGERWORDS_EXACT_MATCH = 0
-	TRIGGERWORDS_STARTS_WITH = 1
+	TRIGGERWORDS_EXACT_MATCH = model.TRIGGERWORDS_EXACT_MATCH
+	TRIGGERWORDS_STARTS_WITH = model.TRIGGERWORDS_STARTS_WITH
 )
 
 func (a *App) handleWebhookEvents(post *model.Post, team *model.Team, channel *model.Channel, user *model.User) *model.AppError {
@@ -55,12 +55,9 @@ func (a *App) handleWebhookEvents(post *model.Post, team *model.Team, channel *m
 			if hook.ChannelId == post.ChannelId && len(hook.TriggerWords) == 0 {
 				relevantHooks = append(relevantHooks, hook)
 				triggerWord = ""
-			} else if hook.TriggerWhen == TRIGGERWORDS_EXACT_MATCH && hook.TriggerWordExactMatch(firstWord) {
+			} else if hook.TriggerWordMatches(firstWord) {
 				relevantHooks = append(relevantHooks, hook)
-				triggerWord = hook.GetTriggerWord(firstWord, true)
-			} else if hook.TriggerWhen == TRIGGERWORDS_STARTS_WITH && hook.TriggerWordStartsWith(firstWord) {
-				relevantHooks = append(relevantHooks, hook)
-				triggerWord = hook.GetTriggerWord(firstWord, false)
+				triggerWord = hook.GetTriggerWord(firstWord, hook.TriggerWhen == model.TRIGGERWORDS_EXACT_MATCH)
 			}
 		}
 	}
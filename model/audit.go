@@ -6,8 +6,15 @@ package model
 import (
 	"encoding/json"
 	"io"
+	"net/url"
+	"sort"
+	"strings"
 )
 
+// AUDIT_FIELD_EXTRA_INFO is the key under which Fields returns ExtraInfo
+// content that was not written with SetFields.
+const AUDIT_FIELD_EXTRA_INFO = "extra_info"
+
 type Audit struct {
 	Id        string `json:"id"`
 	CreateAt  int64  `json:"create_at"`
@@ -28,3 +35,51 @@ func AuditFromJson(data io.Reader) *Audit {
 	json.NewDecoder(data).Decode(&o)
 	return o
 }
+
+// SetFields replaces ExtraInfo with a canonical encoding of fields: space
+// separated key=value pairs sorted by key, with keys and values query escaped.
+func (o *Audit) SetFields(fields map[string]string) {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, url.QueryEscape(key)+"="+url.QueryEscape(fields[key]))
+	}
+
+	o.ExtraInfo = strings.Join(pairs, " ")
+}
+
+// Fields parses ExtraInfo as written by SetFields. Free-form content that
+// does not parse as key=value pairs is returned unchanged under
+// AUDIT_FIELD_EXTRA_INFO.
+func (o *Audit) Fields() map[string]string {
+	fields := make(map[string]string)
+	if o.ExtraInfo == "" {
+		return fields
+	}
+
+	for _, pair := range strings.Split(o.ExtraInfo, " ") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return map[string]string{AUDIT_FIELD_EXTRA_INFO: o.ExtraInfo}
+		}
+
+		key, err := url.QueryUnescape(parts[0])
+		if err != nil {
+			return map[string]string{AUDIT_FIELD_EXTRA_INFO: o.ExtraInfo}
+		}
+
+		value, err := url.QueryUnescape(parts[1])
+		if err != nil {
+			return map[string]string{AUDIT_FIELD_EXTRA_INFO: o.ExtraInfo}
+		}
+
+		fields[key] = value
+	}
+
+	return fields
+}
@@ -6,6 +6,8 @@ package model
 import (
 	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAuditJson(t *testing.T) {
@@ -17,3 +19,52 @@ func TestAuditJson(t *testing.T) {
 		t.Fatal("Ids do not match")
 	}
 }
+
+func TestAuditSetFields(t *testing.T) {
+	audit := Audit{}
+
+	audit.SetFields(map[string]string{"user_id": "abc", "attempt": "1"})
+	assert.Equal(t, "attempt=1 user_id=abc", audit.ExtraInfo)
+
+	audit.SetFields(nil)
+	assert.Equal(t, "", audit.ExtraInfo)
+}
+
+func TestAuditFieldsRoundTrip(t *testing.T) {
+	for _, fields := range []map[string]string{
+		{},
+		{"user_id": NewId()},
+		{"user_id": NewId(), "session_user": NewId(), "team_id": NewId()},
+		{"reason": "password was incorrect", "email": "test+1@example.com"},
+		{"query": "a=b&c=d", "path": "/api/v4/users?page=0", "percent": "100%"},
+		{"empty": "", "unicode": "héllo wörld"},
+		{"key with spaces": "value", "key=with=equals": "value"},
+	} {
+		audit := Audit{}
+		audit.SetFields(fields)
+
+		assert.Equal(t, fields, audit.Fields())
+
+		result := AuditFromJson(strings.NewReader(audit.ToJson()))
+		assert.Equal(t, fields, result.Fields())
+	}
+}
+
+func TestAuditFieldsLegacy(t *testing.T) {
+	for _, extraInfo := range []string{
+		"attempt",
+		"success",
+		"attempt user_id=abc",
+		"user_id=abc  session_user=def",
+		"failed - bad password",
+		"=value",
+		"value=%zz",
+	} {
+		audit := Audit{ExtraInfo: extraInfo}
+		assert.Equal(t, map[string]string{AUDIT_FIELD_EXTRA_INFO: extraInfo}, audit.Fields(), extraInfo)
+		assert.Equal(t, extraInfo, audit.ExtraInfo)
+	}
+
+	audit := Audit{ExtraInfo: "user_id=abc session_user=def"}
+	assert.Equal(t, map[string]string{"user_id": "abc", "session_user": "def"}, audit.Fields())
+}
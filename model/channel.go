@@ -34,6 +34,30 @@ const (
 	CHANNEL_SORT_BY_STATUS   = "status"
 )
 
+// ChannelType is the type of a channel. The CHANNEL_OPEN, CHANNEL_PRIVATE, CHANNEL_DIRECT and
+// CHANNEL_GROUP constants are untyped, so they can be used as either a string or a ChannelType.
+type ChannelType string
+
+// ParseChannelType converts the given string into a ChannelType, returning an error if it is not a
+// known channel type.
+func ParseChannelType(s string) (ChannelType, error) {
+	channelType := ChannelType(s)
+	if !channelType.IsValid() {
+		return "", NewAppError("ParseChannelType", "model.channel.is_valid.type.app_error", nil, "type="+s, http.StatusBadRequest)
+	}
+
+	return channelType, nil
+}
+
+func (t ChannelType) IsValid() bool {
+	switch t {
+	case CHANNEL_OPEN, CHANNEL_PRIVATE, CHANNEL_DIRECT, CHANNEL_GROUP:
+		return true
+	}
+
+	return false
+}
+
 type Channel struct {
 	Id            string                 `json:"id"`
 	CreateAt      int64                  `json:"create_at"`
@@ -121,10 +145,14 @@ func (o *Channel) IsValid() *AppError {
 		return NewAppError("Channel.IsValid", "model.channel.is_valid.2_or_more.app_error", nil, "id="+o.Id, http.StatusBadRequest)
 	}
 
-	if !(o.Type == CHANNEL_OPEN || o.Type == CHANNEL_PRIVATE || o.Type == CHANNEL_DIRECT || o.Type == CHANNEL_GROUP) {
+	if !ChannelType(o.Type).IsValid() {
 		return NewAppError("Channel.IsValid", "model.channel.is_valid.type.app_error", nil, "id="+o.Id, http.StatusBadRequest)
 	}
 
+	if o.Type == CHANNEL_DIRECT && !isValidDMName(o.Name) {
+		return NewAppError("Channel.IsValid", "model.channel.is_valid.direct_name.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
 	if utf8.RuneCountInString(o.Header) > CHANNEL_HEADER_MAX_RUNES {
 		return NewAppError("Channel.IsValid", "model.channel.is_valid.header.app_error", nil, "id="+o.Id, http.StatusBadRequest)
 	}
@@ -158,6 +186,10 @@ func (o *Channel) IsGroupOrDirect() bool {
 	return o.Type == CHANNEL_DIRECT || o.Type == CHANNEL_GROUP
 }
 
+func (o *Channel) IsOpen() bool {
+	return o.Type == CHANNEL_OPEN
+}
+
 func (o *Channel) Patch(patch *ChannelPatch) {
 	if patch.DisplayName != nil {
 		o.DisplayName = *patch.DisplayName
@@ -196,6 +228,17 @@ func GetDMNameFromIds(userId1, userId2 string) string {
 	}
 }
 
+// isValidDMName returns true if name is exactly what GetDMNameFromIds produces for the two user
+// ids it contains, which requires them to be joined by a double underscore in sorted order.
+func isValidDMName(name string) bool {
+	ids := strings.Split(name, "__")
+	if len(ids) != 2 || len(ids[0]) != 26 || len(ids[1]) != 26 {
+		return false
+	}
+
+	return GetDMNameFromIds(ids[0], ids[1]) == name
+}
+
 func GetGroupDisplayNameFromUsers(users []*User, truncate bool) string {
 	usernames := make([]string, len(users))
 	for index, user := range users {
@@ -40,6 +40,21 @@ func (o *ChannelList) Etag() string {
 	return Etag(id, t, delta, len(*o))
 }
 
+// IdsByType returns the ids of the channels in the list grouped by channel type, preserving their
+// order within each type.
+func (o *ChannelList) IdsByType() map[string][]string {
+	ids := make(map[string][]string)
+	if o == nil {
+		return ids
+	}
+
+	for _, channel := range *o {
+		ids[channel.Type] = append(ids[channel.Type], channel.Id)
+	}
+
+	return ids
+}
+
 func ChannelListFromJson(data io.Reader) *ChannelList {
 	var o *ChannelList
 	json.NewDecoder(data).Decode(&o)
@@ -0,0 +1,30 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See License.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelListIdsByType(t *testing.T) {
+	var nilList *ChannelList
+	assert.Equal(t, map[string][]string{}, nilList.IdsByType())
+	assert.Equal(t, map[string][]string{}, (&ChannelList{}).IdsByType())
+
+	list := ChannelList{
+		{Id: "open1", Type: CHANNEL_OPEN},
+		{Id: "private1", Type: CHANNEL_PRIVATE},
+		{Id: "open2", Type: CHANNEL_OPEN},
+		{Id: "direct1", Type: CHANNEL_DIRECT},
+		{Id: "open3", Type: CHANNEL_OPEN},
+	}
+
+	assert.Equal(t, map[string][]string{
+		CHANNEL_OPEN:    {"open1", "open2", "open3"},
+		CHANNEL_PRIVATE: {"private1"},
+		CHANNEL_DIRECT:  {"direct1"},
+	}, list.IdsByType())
+}
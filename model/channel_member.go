@@ -126,6 +126,12 @@ func (o *ChannelMember) IsValid() *AppError {
 		}
 	}
 
+	for _, role := range o.GetRoles() {
+		if !IsValidRoleName(role) {
+			return NewAppError("ChannelMember.IsValid", "model.channel_member.is_valid.roles.app_error", nil, "role="+role, http.StatusBadRequest)
+		}
+	}
+
 	return nil
 }
 
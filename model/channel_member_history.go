@@ -9,3 +9,13 @@ type ChannelMemberHistory struct {
 	JoinTime  int64
 	LeaveTime *int64
 }
+
+// WasMemberDuring reports whether the user was a member of the channel at any point between start
+// and end, inclusive. A nil or zero LeaveTime means the user is still a member.
+func (o *ChannelMemberHistory) WasMemberDuring(start, end int64) bool {
+	if o.JoinTime > end {
+		return false
+	}
+
+	return o.LeaveTime == nil || *o.LeaveTime == 0 || *o.LeaveTime >= start
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2017-present Mattermost, Inc. All Rights Reserved.
+// See License.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelMemberHistoryWasMemberDuring(t *testing.T) {
+	leaveTime := int64(200)
+	zero := int64(0)
+
+	for _, tc := range []struct {
+		Name      string
+		LeaveTime *int64
+		Start     int64
+		End       int64
+		Expected  bool
+	}{
+		{"window inside membership", &leaveTime, 120, 180, true},
+		{"membership inside window", &leaveTime, 50, 250, true},
+		{"window overlapping join", &leaveTime, 50, 150, true},
+		{"window overlapping leave", &leaveTime, 150, 250, true},
+		{"window ending at join", &leaveTime, 50, 100, true},
+		{"window starting at leave", &leaveTime, 200, 250, true},
+		{"window before join", &leaveTime, 10, 99, false},
+		{"window after leave", &leaveTime, 201, 300, false},
+		{"still a member", nil, 1000, 2000, true},
+		{"still a member with zero leave time", &zero, 1000, 2000, true},
+		{"still a member, window before join", nil, 10, 99, false},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			history := ChannelMemberHistory{ChannelId: NewId(), UserId: NewId(), JoinTime: 100, LeaveTime: tc.LeaveTime}
+			assert.Equal(t, tc.Expected, history.WasMemberDuring(tc.Start, tc.End))
+		})
+	}
+}
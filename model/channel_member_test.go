@@ -68,6 +68,29 @@ func TestChannelMemberIsValid(t *testing.T) {
 	if err := o.IsValid(); err != nil {
 		t.Fatal(err)
 	}
+
+	o.Roles = CHANNEL_USER_ROLE_ID + " " + CHANNEL_ADMIN_ROLE_ID + " custom_role"
+	if err := o.IsValid(); err != nil {
+		t.Fatal(err)
+	}
+
+	o.Roles = CHANNEL_USER_ROLE_ID + " Channel-Admin"
+	if err := o.IsValid(); err == nil || err.Id != "model.channel_member.is_valid.roles.app_error" {
+		t.Fatal("should be invalid")
+	}
+}
+
+func TestChannelMemberGetRoles(t *testing.T) {
+	o := ChannelMember{Roles: "  channel_user   channel_admin "}
+	roles := o.GetRoles()
+	if len(roles) != 2 || roles[0] != CHANNEL_USER_ROLE_ID || roles[1] != CHANNEL_ADMIN_ROLE_ID {
+		t.Fatal("roles not split correctly", roles)
+	}
+
+	o.Roles = ""
+	if len(o.GetRoles()) != 0 {
+		t.Fatal("should have no roles")
+	}
 }
 
 func TestChannelUnreadJson(t *testing.T) {
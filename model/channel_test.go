@@ -6,6 +6,8 @@ package model
 import (
 	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestChannelJson(t *testing.T) {
@@ -60,6 +62,30 @@ func TestChannelPatch(t *testing.T) {
 	}
 }
 
+func TestChannelPatchHeaderOnly(t *testing.T) {
+	o := Channel{Id: NewId(), Name: "name", DisplayName: "display name", Header: "header", Purpose: "purpose"}
+
+	o.Patch(&ChannelPatch{Header: NewString("new header")})
+	assert.Equal(t, "new header", o.Header)
+	assert.Equal(t, "purpose", o.Purpose)
+	assert.Equal(t, "display name", o.DisplayName)
+	assert.Equal(t, "name", o.Name)
+
+	// A patch decoded from a request that only contains the header must not touch the other fields
+	patch := ChannelPatchFromJson(strings.NewReader(`{"header": ""}`))
+	assert.Nil(t, patch.Purpose)
+	assert.Nil(t, patch.DisplayName)
+
+	o.Patch(patch)
+	assert.Equal(t, "", o.Header)
+	assert.Equal(t, "purpose", o.Purpose)
+	assert.Equal(t, "display name", o.DisplayName)
+
+	o.Patch(&ChannelPatch{Purpose: NewString("new purpose")})
+	assert.Equal(t, "", o.Header)
+	assert.Equal(t, "new purpose", o.Purpose)
+}
+
 func TestChannelIsValid(t *testing.T) {
 	o := Channel{}
 
@@ -134,6 +160,90 @@ func TestChannelIsValid(t *testing.T) {
 	if err := o.IsValid(); err != nil {
 		t.Fatal(err)
 	}
+
+	o.Type = CHANNEL_DIRECT
+	if err := o.IsValid(); err == nil || err.Id != "model.channel.is_valid.direct_name.app_error" {
+		t.Fatal("should be invalid")
+	}
+
+	o.Name = NewId() + "__" + NewId()[:20]
+	if err := o.IsValid(); err == nil || err.Id != "model.channel.is_valid.direct_name.app_error" {
+		t.Fatal("should be invalid")
+	}
+
+	o.Name = GetDMNameFromIds(NewId(), NewId())
+	if err := o.IsValid(); err != nil {
+		t.Fatal(err)
+	}
+
+	userId1 := NewId()
+	userId2 := NewId()
+	if userId1 > userId2 {
+		userId1, userId2 = userId2, userId1
+	}
+
+	o.Name = userId1 + "__" + userId2
+	if err := o.IsValid(); err != nil {
+		t.Fatal(err)
+	}
+
+	o.Name = userId2 + "__" + userId1
+	if err := o.IsValid(); err == nil || err.Id != "model.channel.is_valid.direct_name.app_error" {
+		t.Fatal("should be invalid with unsorted ids")
+	}
+
+	o.Name = GetDMNameFromIds(userId1, userId1)
+	if err := o.IsValid(); err != nil {
+		t.Fatal(err)
+	}
+
+	o.Type = CHANNEL_GROUP
+	o.Name = "zzzzz"
+	if err := o.IsValid(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChannelTypeHelpers(t *testing.T) {
+	for _, tc := range []struct {
+		Type            string
+		IsOpen          bool
+		IsGroupOrDirect bool
+	}{
+		{CHANNEL_OPEN, true, false},
+		{CHANNEL_PRIVATE, false, false},
+		{CHANNEL_DIRECT, false, true},
+		{CHANNEL_GROUP, false, true},
+	} {
+		t.Run(tc.Type, func(t *testing.T) {
+			o := Channel{Type: tc.Type}
+			assert.Equal(t, tc.IsOpen, o.IsOpen())
+			assert.Equal(t, tc.IsGroupOrDirect, o.IsGroupOrDirect())
+		})
+	}
+}
+
+func TestParseChannelType(t *testing.T) {
+	for _, value := range []string{CHANNEL_OPEN, CHANNEL_PRIVATE, CHANNEL_DIRECT, CHANNEL_GROUP} {
+		t.Run(value, func(t *testing.T) {
+			channelType, err := ParseChannelType(value)
+			assert.Nil(t, err)
+			assert.Equal(t, ChannelType(value), channelType)
+			assert.True(t, channelType.IsValid())
+		})
+	}
+
+	for _, value := range []string{"", "X", "o", "OP"} {
+		t.Run("invalid "+value, func(t *testing.T) {
+			channelType, err := ParseChannelType(value)
+			assert.NotNil(t, err)
+			assert.Equal(t, ChannelType(""), channelType)
+			assert.False(t, ChannelType(value).IsValid())
+		})
+	}
+
+	var channelType ChannelType = CHANNEL_OPEN
+	assert.True(t, channelType.IsValid())
 }
 
 func TestChannelPreSave(t *testing.T) {
@@ -4,8 +4,12 @@
 package model
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
 	"encoding/json"
 	"io"
+	"io/ioutil"
 )
 
 const (
@@ -33,6 +37,7 @@ type ClusterMessage struct {
 	SendType         string            `json:"-"`
 	WaitForAllToSend bool              `json:"-"`
 	Data             string            `json:"data,omitempty"`
+	Compressed       bool              `json:"compressed,omitempty"`
 	Props            map[string]string `json:"props,omitempty"`
 }
 
@@ -46,3 +51,24 @@ func ClusterMessageFromJson(data io.Reader) *ClusterMessage {
 	json.NewDecoder(data).Decode(&o)
 	return o
 }
+
+// DecodedData returns the message payload. When Compressed is set, Data is expected to hold
+// base64-encoded gzip data, which is decoded and decompressed.
+func (o *ClusterMessage) DecodedData() ([]byte, error) {
+	if !o.Compressed {
+		return []byte(o.Data), nil
+	}
+
+	compressed, err := base64.StdEncoding.DecodeString(o.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return ioutil.ReadAll(reader)
+}
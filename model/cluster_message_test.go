@@ -4,6 +4,9 @@
 package model
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
 	"strings"
 	"testing"
 
@@ -26,3 +29,45 @@ func TestClusterMessage(t *testing.T) {
 		t.Fatal("should not have parsed")
 	}
 }
+
+func TestClusterMessageDecodedData(t *testing.T) {
+	t.Run("uncompressed", func(t *testing.T) {
+		m := ClusterMessage{Event: CLUSTER_EVENT_PUBLISH, Data: "hello"}
+		result := ClusterMessageFromJson(strings.NewReader(m.ToJson()))
+		require.False(t, result.Compressed)
+
+		data, err := result.DecodedData()
+		require.Nil(t, err)
+		require.Equal(t, "hello", string(data))
+	})
+
+	t.Run("compressed", func(t *testing.T) {
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		_, err := writer.Write([]byte("hello"))
+		require.Nil(t, err)
+		require.Nil(t, writer.Close())
+
+		m := ClusterMessage{
+			Event:      CLUSTER_EVENT_PUBLISH,
+			Data:       base64.StdEncoding.EncodeToString(buf.Bytes()),
+			Compressed: true,
+		}
+		result := ClusterMessageFromJson(strings.NewReader(m.ToJson()))
+		require.True(t, result.Compressed)
+
+		data, err := result.DecodedData()
+		require.Nil(t, err)
+		require.Equal(t, "hello", string(data))
+	})
+
+	t.Run("invalid compressed data", func(t *testing.T) {
+		m := ClusterMessage{Data: "hello", Compressed: true}
+		_, err := m.DecodedData()
+		require.NotNil(t, err)
+
+		m.Data = base64.StdEncoding.EncodeToString([]byte("hello"))
+		_, err = m.DecodedData()
+		require.NotNil(t, err)
+	})
+}
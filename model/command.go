@@ -85,10 +85,14 @@ func (o *Command) IsValid() *AppError {
 		return NewAppError("Command.IsValid", "model.command.is_valid.team_id.app_error", nil, "", http.StatusBadRequest)
 	}
 
-	if len(o.Trigger) < MIN_TRIGGER_LENGTH || len(o.Trigger) > MAX_TRIGGER_LENGTH || strings.Index(o.Trigger, "/") == 0 || strings.Contains(o.Trigger, " ") {
+	if len(o.Trigger) < MIN_TRIGGER_LENGTH || len(o.Trigger) > MAX_TRIGGER_LENGTH || strings.Index(o.Trigger, "/") == 0 {
 		return NewAppError("Command.IsValid", "model.command.is_valid.trigger.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if strings.Contains(o.Trigger, " ") {
+		return NewAppError("Command.IsValid", "model.command.is_valid.trigger_spaces.app_error", nil, "trigger="+o.Trigger, http.StatusBadRequest)
+	}
+
 	if len(o.URL) == 0 || len(o.URL) > 1024 {
 		return NewAppError("Command.IsValid", "model.command.is_valid.url.app_error", nil, "", http.StatusBadRequest)
 	}
@@ -121,12 +125,21 @@ func (o *Command) PreSave() {
 		o.Token = NewId()
 	}
 
+	o.Trigger = NormalizeCommandTrigger(o.Trigger)
+
 	o.CreateAt = GetMillis()
 	o.UpdateAt = o.CreateAt
 }
 
 func (o *Command) PreUpdate() {
 	o.UpdateAt = GetMillis()
+	o.Trigger = NormalizeCommandTrigger(o.Trigger)
+}
+
+// NormalizeCommandTrigger strips a single leading slash from the trigger and lowercases it so
+// that "/Deploy" and "deploy" refer to the same command.
+func NormalizeCommandTrigger(trigger string) string {
+	return strings.ToLower(strings.TrimPrefix(trigger, "/"))
 }
 
 func (o *Command) Sanitize() {
@@ -6,6 +6,8 @@ package model
 import (
 	"encoding/json"
 	"io"
+	"strings"
+	"unicode"
 
 	goi18n "github.com/nicksnyder/go-i18n/i18n"
 )
@@ -33,3 +35,46 @@ func CommandArgsFromJson(data io.Reader) *CommandArgs {
 	json.NewDecoder(data).Decode(&o)
 	return o
 }
+
+// TriggerAndArgs splits Command into the normalized trigger, without its leading slash, and the
+// arguments that follow it. Arguments are separated by whitespace, except that text enclosed in
+// double quotes is kept together as a single argument with the quotes removed. An unterminated
+// quote runs to the end of the command.
+func (o *CommandArgs) TriggerAndArgs() (trigger string, args []string) {
+	fields := splitCommandFields(o.Command)
+	if len(fields) == 0 {
+		return "", nil
+	}
+
+	return NormalizeCommandTrigger(fields[0]), fields[1:]
+}
+
+func splitCommandFields(command string) []string {
+	var fields []string
+	var field strings.Builder
+	inField := false
+	inQuotes := false
+
+	for _, r := range command {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			inField = true
+		case unicode.IsSpace(r) && !inQuotes:
+			if inField {
+				fields = append(fields, field.String())
+				field.Reset()
+				inField = false
+			}
+		default:
+			field.WriteRune(r)
+			inField = true
+		}
+	}
+
+	if inField {
+		fields = append(fields, field.String())
+	}
+
+	return fields
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2016-present Mattermost, Inc. All Rights Reserved.
+// See License.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandArgsJson(t *testing.T) {
+	o := CommandArgs{ChannelId: NewId(), Command: "/echo hello"}
+	ro := CommandArgsFromJson(strings.NewReader(o.ToJson()))
+
+	assert.Equal(t, o.ChannelId, ro.ChannelId)
+	assert.Equal(t, o.Command, ro.Command)
+}
+
+func TestCommandArgsTriggerAndArgs(t *testing.T) {
+	for _, tc := range []struct {
+		Name    string
+		Command string
+		Trigger string
+		Args    []string
+	}{
+		{"empty", "", "", nil},
+		{"only whitespace", "  \t ", "", nil},
+		{"trigger only", "/echo", "echo", []string{}},
+		{"uppercase trigger", "/ECHO hello", "echo", []string{"hello"}},
+		{"simple args", "/echo hello world", "echo", []string{"hello", "world"}},
+		{"extra whitespace", "  /echo   hello \t world  ", "echo", []string{"hello", "world"}},
+		{"newline separated", "/echo hello\nworld", "echo", []string{"hello", "world"}},
+		{"quoted argument", `/echo "hello world" again`, "echo", []string{"hello world", "again"}},
+		{"quoted whitespace kept", `/echo "  spaced  out "`, "echo", []string{"  spaced  out "}},
+		{"empty quotes", `/echo "" x`, "echo", []string{"", "x"}},
+		{"quotes inside word", `/echo key="some value"`, "echo", []string{"key=some value"}},
+		{"unterminated quote", `/echo "hello world`, "echo", []string{"hello world"}},
+		{"unicode", "/echo 日本 \"語 です\"", "echo", []string{"日本", "語 です"}},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			args := &CommandArgs{Command: tc.Command}
+			trigger, parsed := args.TriggerAndArgs()
+
+			assert.Equal(t, tc.Trigger, trigger)
+			assert.Equal(t, tc.Args, parsed)
+		})
+	}
+}
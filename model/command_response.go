@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"io"
 	"io/ioutil"
+	"net/http"
 	"strings"
 
 	"github.com/mattermost/mattermost-server/utils/jsonutils"
@@ -30,6 +31,50 @@ type CommandResponse struct {
 	ExtraResponses []*CommandResponse `json:"extra_responses"`
 }
 
+// CommandResponseBuilder assembles a CommandResponse through chained calls. Use NewCommandResponse
+// to create one.
+type CommandResponseBuilder struct {
+	response *CommandResponse
+}
+
+func NewCommandResponse() *CommandResponseBuilder {
+	return &CommandResponseBuilder{
+		response: &CommandResponse{},
+	}
+}
+
+func (b *CommandResponseBuilder) WithText(text string) *CommandResponseBuilder {
+	b.response.Text = text
+	return b
+}
+
+func (b *CommandResponseBuilder) WithResponseType(responseType string) *CommandResponseBuilder {
+	b.response.ResponseType = responseType
+	return b
+}
+
+func (b *CommandResponseBuilder) AddAttachment(attachment *SlackAttachment) *CommandResponseBuilder {
+	b.response.Attachments = append(b.response.Attachments, attachment)
+	return b
+}
+
+// Build returns the assembled CommandResponse, or an error if its ResponseType is not empty,
+// COMMAND_RESPONSE_TYPE_IN_CHANNEL or COMMAND_RESPONSE_TYPE_EPHEMERAL.
+func (b *CommandResponseBuilder) Build() (*CommandResponse, *AppError) {
+	switch b.response.ResponseType {
+	case "", COMMAND_RESPONSE_TYPE_IN_CHANNEL, COMMAND_RESPONSE_TYPE_EPHEMERAL:
+	default:
+		return nil, NewAppError("CommandResponseBuilder.Build", "model.command_response.build.response_type.app_error", nil, "response_type="+b.response.ResponseType, http.StatusBadRequest)
+	}
+
+	response := *b.response
+	if b.response.Attachments != nil {
+		response.Attachments = append([]*SlackAttachment{}, b.response.Attachments...)
+	}
+
+	return &response, nil
+}
+
 func (o *CommandResponse) ToJson() string {
 	b, _ := json.Marshal(o)
 	return string(b)
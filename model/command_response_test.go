@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCommandResponseFromHTTPBody(t *testing.T) {
@@ -27,6 +28,51 @@ func TestCommandResponseFromHTTPBody(t *testing.T) {
 	}
 }
 
+func TestCommandResponseBuilder(t *testing.T) {
+	t.Run("chained", func(t *testing.T) {
+		attachment1 := &SlackAttachment{Text: "first"}
+		attachment2 := &SlackAttachment{Text: "second"}
+
+		response, err := NewCommandResponse().
+			WithText("foo").
+			WithResponseType(COMMAND_RESPONSE_TYPE_IN_CHANNEL).
+			AddAttachment(attachment1).
+			AddAttachment(attachment2).
+			Build()
+
+		require.Nil(t, err)
+		assert.Equal(t, "foo", response.Text)
+		assert.Equal(t, COMMAND_RESPONSE_TYPE_IN_CHANNEL, response.ResponseType)
+		assert.Equal(t, []*SlackAttachment{attachment1, attachment2}, response.Attachments)
+	})
+
+	t.Run("default response type", func(t *testing.T) {
+		response, err := NewCommandResponse().WithText("foo").Build()
+
+		require.Nil(t, err)
+		assert.Equal(t, "", response.ResponseType)
+		assert.Nil(t, response.Attachments)
+	})
+
+	t.Run("invalid response type", func(t *testing.T) {
+		response, err := NewCommandResponse().WithText("foo").WithResponseType("junk").Build()
+
+		require.NotNil(t, err)
+		assert.Equal(t, "model.command_response.build.response_type.app_error", err.Id)
+		assert.Nil(t, response)
+	})
+
+	t.Run("builds are independent", func(t *testing.T) {
+		builder := NewCommandResponse().WithText("foo")
+		first, err := builder.Build()
+		require.Nil(t, err)
+
+		builder.WithText("bar").AddAttachment(&SlackAttachment{})
+		assert.Equal(t, "foo", first.Text)
+		assert.Nil(t, first.Attachments)
+	})
+}
+
 func TestCommandResponseFromPlainText(t *testing.T) {
 	response := CommandResponseFromPlainText("foo")
 	assert.Equal(t, "foo", response.Text)
@@ -112,6 +112,18 @@ func TestCommandIsValid(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	o.Trigger = "/trigger"
+	if err := o.IsValid(); err == nil {
+		t.Fatal("should be invalid")
+	}
+
+	o.Trigger = "trig ger"
+	if err := o.IsValid(); err == nil || err.Id != "model.command.is_valid.trigger_spaces.app_error" {
+		t.Fatal("should be invalid")
+	}
+
+	o.Trigger = "trigger"
+
 	o.URL = ""
 	if err := o.IsValid(); err == nil {
 		t.Fatal("should be invalid")
@@ -166,9 +178,27 @@ func TestCommandIsValid(t *testing.T) {
 func TestCommandPreSave(t *testing.T) {
 	o := Command{}
 	o.PreSave()
+
+	o = Command{Trigger: "/Deploy"}
+	o.PreSave()
+	if o.Trigger != "deploy" {
+		t.Fatal("trigger should have been normalized", o.Trigger)
+	}
+
+	o = Command{Trigger: "deploy"}
+	o.PreSave()
+	if o.Trigger != "deploy" {
+		t.Fatal("trigger should not have changed", o.Trigger)
+	}
 }
 
 func TestCommandPreUpdate(t *testing.T) {
 	o := Command{}
 	o.PreUpdate()
+
+	o.Trigger = "/Deploy"
+	o.PreUpdate()
+	if o.Trigger != "deploy" {
+		t.Fatal("trigger should be normalized, got " + o.Trigger)
+	}
 }
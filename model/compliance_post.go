@@ -67,6 +67,18 @@ func CompliancePostHeader() []string {
 	}
 }
 
+// CsvHeader returns the column names of the compliance CSV export, matching the order of the
+// values returned by CsvRecord.
+func (me *CompliancePost) CsvHeader() []string {
+	return CompliancePostHeader()
+}
+
+// CsvRecord returns the post's values for the compliance CSV export. Values are not quoted or
+// escaped, which is left to the csv.Writer, apart from the formula prefix added by Row.
+func (me *CompliancePost) CsvRecord() []string {
+	return me.Row()
+}
+
 func cleanComplianceStrings(in string) string {
 	if matched, _ := regexp.MatchString("^\\s*(=|\\+|\\-)", in); matched {
 		return "'" + in
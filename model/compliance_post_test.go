@@ -4,6 +4,8 @@
 package model
 
 import (
+	"bytes"
+	"encoding/csv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -21,6 +23,41 @@ func TestCompliancePost(t *testing.T) {
 	require.Equal(t, "files", r[len(r)-1])
 }
 
+func TestCompliancePostCsv(t *testing.T) {
+	o := CompliancePost{
+		TeamName:     "test",
+		UserEmail:    "test@example.com",
+		PostId:       NewId(),
+		PostMessage:  `hello, "world"`,
+		PostFileIds:  "files",
+		PostCreateAt: GetMillis(),
+	}
+
+	header := o.CsvHeader()
+	record := o.CsvRecord()
+	require.Len(t, record, len(header))
+
+	values := make(map[string]string, len(header))
+	for i, column := range header {
+		values[column] = record[i]
+	}
+	require.Equal(t, "test", values["TeamName"])
+	require.Equal(t, "test@example.com", values["UserEmail"])
+	require.Equal(t, o.PostId, values["PostId"])
+	require.Equal(t, `hello, "world"`, values["PostMessage"])
+	require.Equal(t, "files", values["PostFileIds"])
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	require.Nil(t, w.Write(header))
+	require.Nil(t, w.Write(record))
+	w.Flush()
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.Nil(t, err)
+	require.Equal(t, [][]string{header, record}, rows)
+}
+
 var cleanTests = []struct {
 	in       string
 	expected string
@@ -2101,6 +2101,10 @@ func (o *Config) IsValid() *AppError {
 		return err
 	}
 
+	if err := o.PluginSettings.isValid(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -2514,6 +2518,14 @@ func (ds *DisplaySettings) isValid() *AppError {
 	return nil
 }
 
+func (s *PluginSettings) isValid() *AppError {
+	if *s.Enable && *s.EnableUploads && len(*s.Directory) == 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.plugin_uploads_directory.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
 func (o *Config) GetSanitizeOptions() map[string]bool {
 	options := map[string]bool{}
 	options["fullname"] = o.PrivacySettings.ShowFullName
@@ -523,6 +523,38 @@ func TestDisplaySettingsIsValidCustomUrlSchemes(t *testing.T) {
 	}
 }
 
+func TestPluginSettingsIsValidUploadsDirectory(t *testing.T) {
+	tests := []struct {
+		name          string
+		enable        bool
+		enableUploads bool
+		directory     string
+		valid         bool
+	}{
+		{"uploads disabled, no directory", true, false, "", true},
+		{"uploads enabled, directory set", true, true, "./plugins", true},
+		{"uploads enabled, no directory", true, true, "", false},
+		{"plugins disabled, no directory", false, true, "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ps := &PluginSettings{}
+			ps.SetDefaults()
+
+			ps.Enable = NewBool(test.enable)
+			ps.EnableUploads = NewBool(test.enableUploads)
+			ps.Directory = NewString(test.directory)
+
+			err := ps.isValid()
+			if test.valid {
+				assert.Nil(t, err)
+			} else if assert.NotNil(t, err) {
+				assert.Equal(t, "model.config.is_valid.plugin_uploads_directory.app_error", err.Id)
+			}
+		})
+	}
+}
+
 func TestListenAddressIsValidated(t *testing.T) {
 
 	testValues := map[string]bool{
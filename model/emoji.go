@@ -26,6 +26,12 @@ type Emoji struct {
 	Name      string `json:"name"`
 }
 
+type EmojiList []*Emoji
+
+type EmojiPatch struct {
+	Name *string `json:"name"`
+}
+
 func inSystemEmoji(emojiName string) bool {
 	_, ok := SystemEmojis[emojiName]
 	return ok
@@ -52,10 +58,14 @@ func (emoji *Emoji) IsValid() *AppError {
 }
 
 func IsValidEmojiName(name string) *AppError {
-	if len(name) == 0 || len(name) > EMOJI_NAME_MAX_LENGTH || !IsValidAlphaNumHyphenUnderscore(name, false) || inSystemEmoji(name) {
+	if len(name) == 0 || len(name) > EMOJI_NAME_MAX_LENGTH || !IsValidAlphaNumHyphenUnderscore(name, false) {
 		return NewAppError("Emoji.IsValid", "model.emoji.name.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if inSystemEmoji(name) {
+		return NewAppError("Emoji.IsValid", "model.emoji.system_emoji_name.app_error", nil, "name="+name, http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -68,6 +78,30 @@ func (emoji *Emoji) PreSave() {
 	emoji.UpdateAt = emoji.CreateAt
 }
 
+// Patch applies the given patch to the emoji. Callers are expected to call IsValid afterwards to
+// make sure that the new name is acceptable.
+func (emoji *Emoji) Patch(patch *EmojiPatch) {
+	if patch.Name != nil {
+		emoji.Name = *patch.Name
+	}
+}
+
+func (emoji *Emoji) Etag() string {
+	return Etag(emoji.Id, emoji.UpdateAt)
+}
+
+func (o EmojiList) Etag() string {
+	var t int64 = 0
+
+	for _, v := range o {
+		if v.UpdateAt > t {
+			t = v.UpdateAt
+		}
+	}
+
+	return Etag(len(o), t)
+}
+
 func (emoji *Emoji) ToJson() string {
 	b, _ := json.Marshal(emoji)
 	return string(b)
@@ -89,3 +123,21 @@ func EmojiListFromJson(data io.Reader) []*Emoji {
 	json.NewDecoder(data).Decode(&emojiList)
 	return emojiList
 }
+
+func (patch *EmojiPatch) ToJson() string {
+	b, err := json.Marshal(patch)
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
+
+func EmojiPatchFromJson(data io.Reader) *EmojiPatch {
+	var patch EmojiPatch
+	if err := json.NewDecoder(data).Decode(&patch); err != nil {
+		return nil
+	}
+
+	return &patch
+}
@@ -59,5 +59,75 @@ func TestEmojiIsValid(t *testing.T) {
 	require.NotNil(t, emoji.IsValid())
 
 	emoji.Name = "croissant"
+	err := emoji.IsValid()
+	require.NotNil(t, err)
+	require.Equal(t, "model.emoji.system_emoji_name.app_error", err.Id)
+
+	emoji.Name = "croissant-custom"
+	require.Nil(t, emoji.IsValid())
+}
+
+func TestEmojiPatch(t *testing.T) {
+	emoji := Emoji{
+		Id:        NewId(),
+		CreateAt:  1234,
+		UpdateAt:  1234,
+		CreatorId: NewId(),
+		Name:      "name",
+	}
+	require.Nil(t, emoji.IsValid())
+
+	emoji.Patch(&EmojiPatch{})
+	require.Equal(t, "name", emoji.Name)
+
+	emoji.Patch(&EmojiPatch{Name: NewString("new-name")})
+	require.Equal(t, "new-name", emoji.Name)
+	require.Equal(t, int64(1234), emoji.CreateAt)
+	require.Nil(t, emoji.IsValid())
+
+	emoji.Patch(&EmojiPatch{Name: NewString("croissant")})
+	err := emoji.IsValid()
+	require.NotNil(t, err)
+	require.Equal(t, "model.emoji.system_emoji_name.app_error", err.Id)
+
+	emoji.Patch(&EmojiPatch{Name: NewString("")})
 	require.NotNil(t, emoji.IsValid())
 }
+
+func TestEmojiPatchJson(t *testing.T) {
+	patch := &EmojiPatch{Name: NewString("name")}
+	require.Equal(t, patch, EmojiPatchFromJson(strings.NewReader(patch.ToJson())))
+	require.Nil(t, EmojiPatchFromJson(strings.NewReader("junk")))
+}
+
+func TestEmojiEtag(t *testing.T) {
+	emoji := &Emoji{Id: NewId(), UpdateAt: 1000}
+	etag := emoji.Etag()
+
+	require.Equal(t, etag, emoji.Etag())
+
+	emoji.UpdateAt = 2000
+	require.NotEqual(t, etag, emoji.Etag())
+}
+
+func TestEmojiListEtag(t *testing.T) {
+	emoji1 := &Emoji{Id: NewId(), UpdateAt: 1000}
+	emoji2 := &Emoji{Id: NewId(), UpdateAt: 2000}
+	emoji3 := &Emoji{Id: NewId(), UpdateAt: 1500}
+
+	list := EmojiList{emoji1, emoji2}
+	etag := list.Etag()
+
+	require.Equal(t, etag, EmojiList{emoji1, emoji2}.Etag())
+
+	added := append(EmojiList{}, emoji1, emoji2, emoji3)
+	require.NotEqual(t, etag, added.Etag(), "adding an emoji should change the etag")
+
+	removed := EmojiList{emoji2}
+	require.NotEqual(t, etag, removed.Etag(), "removing an emoji should change the etag")
+
+	emoji1.UpdateAt = 3000
+	require.NotEqual(t, etag, list.Etag(), "updating an emoji should change the etag")
+
+	require.Equal(t, EmojiList{}.Etag(), EmojiList(nil).Etag())
+}
@@ -15,6 +15,13 @@ import (
 	"strings"
 )
 
+// measuredImageMimeTypes are the image types that GetInfoForBytes records the dimensions of.
+var measuredImageMimeTypes = map[string]bool{
+	"image/gif":  true,
+	"image/jpeg": true,
+	"image/png":  true,
+}
+
 type FileInfo struct {
 	Id              string `json:"id"`
 	CreatorId       string `json:"user_id"`
@@ -34,6 +41,10 @@ type FileInfo struct {
 	HasPreviewImage bool   `json:"has_preview_image,omitempty"`
 }
 
+type FileInfoPatch struct {
+	Name *string `json:"name"`
+}
+
 func (info *FileInfo) ToJson() string {
 	b, _ := json.Marshal(info)
 	return string(b)
@@ -105,9 +116,55 @@ func (o *FileInfo) IsValid() *AppError {
 		return NewAppError("FileInfo.IsValid", "model.file_info.is_valid.path.app_error", nil, "id="+o.Id, http.StatusBadRequest)
 	}
 
+	if strings.ContainsAny(o.Name, "/\\") || o.Name == "." || o.Name == ".." {
+		return NewAppError("FileInfo.IsValid", "model.file_info.is_valid.name.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	// The stored file, its mime type and its dimensions all depend on the extension, so a file
+	// can't be renamed to a different one.
+	if strings.TrimPrefix(strings.ToLower(filepath.Ext(o.Name)), ".") != o.Extension {
+		return NewAppError("FileInfo.IsValid", "model.file_info.is_valid.extension.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if measuredImageMimeTypes[o.MimeType] {
+		// GetInfoForBytes leaves images it can't decode without dimensions or a preview, so only
+		// images with a preview are required to have them.
+		if o.HasPreviewImage && (o.Width <= 0 || o.Height <= 0) {
+			return NewAppError("FileInfo.IsValid", "model.file_info.is_valid.dimensions.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+		}
+	} else if !o.IsImage() && (o.Width != 0 || o.Height != 0) {
+		return NewAppError("FileInfo.IsValid", "model.file_info.is_valid.dimensions.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
 	return nil
 }
 
+// Patch applies the given patch to the file info. Callers are expected to call IsValid afterwards
+// to make sure that the new name is acceptable.
+func (o *FileInfo) Patch(patch *FileInfoPatch) {
+	if patch.Name != nil {
+		o.Name = *patch.Name
+	}
+}
+
+func (patch *FileInfoPatch) ToJson() string {
+	b, err := json.Marshal(patch)
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
+
+func FileInfoPatchFromJson(data io.Reader) *FileInfoPatch {
+	var patch FileInfoPatch
+	if err := json.NewDecoder(data).Decode(&patch); err != nil {
+		return nil
+	}
+
+	return &patch
+}
+
 func (o *FileInfo) IsImage() bool {
 	return strings.HasPrefix(o.MimeType, "image")
 }
@@ -10,6 +10,8 @@ import (
 	"io/ioutil"
 	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestFileInfoIsValid(t *testing.T) {
@@ -60,6 +62,131 @@ func TestFileInfoIsValid(t *testing.T) {
 	}
 }
 
+func TestFileInfoIsValidDimensions(t *testing.T) {
+	info := &FileInfo{
+		Id:        NewId(),
+		CreatorId: NewId(),
+		CreateAt:  1234,
+		UpdateAt:  1234,
+		Path:      "fake/path.png",
+	}
+
+	for _, testCase := range []struct {
+		Name            string
+		MimeType        string
+		Width           int
+		Height          int
+		HasPreviewImage bool
+		Valid           bool
+	}{
+		{"png with dimensions", "image/png", 100, 50, true, true},
+		{"png without dimensions", "image/png", 0, 0, true, false},
+		{"png without height", "image/png", 100, 0, true, false},
+		{"undecodable png without dimensions", "image/png", 0, 0, false, true},
+		{"animated gif with dimensions", "image/gif", 100, 50, false, true},
+		{"pdf without dimensions", "application/pdf", 0, 0, false, true},
+		{"pdf with dimensions", "application/pdf", 100, 50, false, false},
+		{"svg without dimensions", "image/svg+xml", 0, 0, false, true},
+	} {
+		t.Run(testCase.Name, func(t *testing.T) {
+			info.MimeType = testCase.MimeType
+			info.Width = testCase.Width
+			info.Height = testCase.Height
+			info.HasPreviewImage = testCase.HasPreviewImage
+
+			err := info.IsValid()
+			if testCase.Valid && err != nil {
+				t.Fatal(err)
+			} else if !testCase.Valid && (err == nil || err.Id != "model.file_info.is_valid.dimensions.app_error") {
+				t.Fatal("dimensions should be invalid")
+			}
+		})
+	}
+}
+
+func TestFileInfoPatch(t *testing.T) {
+	newInfo := func() *FileInfo {
+		return &FileInfo{
+			Id:              NewId(),
+			CreatorId:       NewId(),
+			CreateAt:        1234,
+			UpdateAt:        1234,
+			Path:            "fake/original.png",
+			Name:            "original.png",
+			Extension:       "png",
+			MimeType:        "image/png",
+			Width:           640,
+			Height:          480,
+			HasPreviewImage: true,
+		}
+	}
+
+	info := newInfo()
+	info.Patch(&FileInfoPatch{Name: NewString("renamed.PNG")})
+	assert.Nil(t, info.IsValid())
+	assert.Equal(t, "renamed.PNG", info.Name)
+	assert.Equal(t, "png", info.Extension)
+	assert.Equal(t, "image/png", info.MimeType)
+	assert.Equal(t, 640, info.Width)
+	assert.Equal(t, 480, info.Height)
+
+	info.Patch(&FileInfoPatch{})
+	assert.Equal(t, "renamed.PNG", info.Name)
+
+	for _, name := range []string{"../original.png", "dir/renamed.png", "dir\\renamed.png", "/renamed.png", "..", "."} {
+		info := newInfo()
+		info.Patch(&FileInfoPatch{Name: NewString(name)})
+		if err := info.IsValid(); assert.NotNil(t, err, name) {
+			assert.Equal(t, "model.file_info.is_valid.name.app_error", err.Id)
+		}
+	}
+
+	for _, name := range []string{"", "renamed.jpg", "renamed", "renamed.png.exe"} {
+		info := newInfo()
+		info.Patch(&FileInfoPatch{Name: NewString(name)})
+		if err := info.IsValid(); assert.NotNil(t, err, name) {
+			assert.Equal(t, "model.file_info.is_valid.extension.app_error", err.Id)
+		}
+	}
+
+	info = newInfo()
+	info.Name = "README"
+	info.Extension = ""
+	info.MimeType = ""
+	info.Width = 0
+	info.Height = 0
+	info.HasPreviewImage = false
+	info.Patch(&FileInfoPatch{Name: NewString("NOTES")})
+	assert.Nil(t, info.IsValid())
+	assert.Equal(t, "NOTES", info.Name)
+}
+
+func TestFileInfoPatchJson(t *testing.T) {
+	p := &FileInfoPatch{Name: NewString("file.txt")}
+	rp := FileInfoPatchFromJson(strings.NewReader(p.ToJson()))
+
+	assert.Equal(t, p, rp)
+	assert.Nil(t, FileInfoPatchFromJson(strings.NewReader("junk")))
+}
+
+func TestGetInfoForUndecodableImageIsValid(t *testing.T) {
+	info, err := GetInfoForBytes("corrupt.png", []byte("not really a png"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info.Id = NewId()
+	info.CreatorId = NewId()
+	info.CreateAt = 1234
+	info.UpdateAt = 1234
+	info.Path = "fake/corrupt.png"
+
+	assert.Equal(t, 0, info.Width)
+	assert.Equal(t, 0, info.Height)
+	assert.False(t, info.HasPreviewImage)
+	assert.Nil(t, info.IsValid())
+}
+
 func TestFileInfoIsImage(t *testing.T) {
 	info := &FileInfo{
 		MimeType: "image/png",
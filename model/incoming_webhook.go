@@ -30,6 +30,14 @@ type IncomingWebhook struct {
 	ChannelLocked bool   `json:"channel_locked"`
 }
 
+type IncomingWebhookPatch struct {
+	DisplayName *string `json:"display_name"`
+	Description *string `json:"description"`
+	ChannelId   *string `json:"channel_id"`
+	Username    *string `json:"username"`
+	IconURL     *string `json:"icon_url"`
+}
+
 type IncomingWebhookRequest struct {
 	Text        string             `json:"text"`
 	Username    string             `json:"username"`
@@ -121,6 +129,48 @@ func (o *IncomingWebhook) PreUpdate() {
 	o.UpdateAt = GetMillis()
 }
 
+// Patch applies the given patch to the webhook, leaving fields that aren't set in the patch, such
+// as ChannelLocked, untouched. Callers are expected to call IsValid afterwards.
+func (o *IncomingWebhook) Patch(patch *IncomingWebhookPatch) {
+	if patch.DisplayName != nil {
+		o.DisplayName = *patch.DisplayName
+	}
+
+	if patch.Description != nil {
+		o.Description = *patch.Description
+	}
+
+	if patch.ChannelId != nil {
+		o.ChannelId = *patch.ChannelId
+	}
+
+	if patch.Username != nil {
+		o.Username = *patch.Username
+	}
+
+	if patch.IconURL != nil {
+		o.IconURL = *patch.IconURL
+	}
+}
+
+func (patch *IncomingWebhookPatch) ToJson() string {
+	b, err := json.Marshal(patch)
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
+
+func IncomingWebhookPatchFromJson(data io.Reader) *IncomingWebhookPatch {
+	var patch IncomingWebhookPatch
+	if err := json.NewDecoder(data).Decode(&patch); err != nil {
+		return nil
+	}
+
+	return &patch
+}
+
 // escapeControlCharsFromPayload escapes control chars (\n, \t) from a byte slice.
 // Context:
 // JSON strings are not supposed to contain control characters such as \n, \t,
@@ -6,6 +6,8 @@ package model
 import (
 	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestIncomingWebhookJson(t *testing.T) {
@@ -111,6 +113,88 @@ func TestIncomingWebhookIsValid(t *testing.T) {
 	}
 }
 
+func TestIncomingWebhookIsValidChannelLocked(t *testing.T) {
+	o := IncomingWebhook{
+		Id:            NewId(),
+		CreateAt:      GetMillis(),
+		UpdateAt:      GetMillis(),
+		UserId:        NewId(),
+		TeamId:        NewId(),
+		ChannelLocked: true,
+	}
+
+	if err := o.IsValid(); err == nil || err.Id != "model.incoming_hook.channel_id.app_error" {
+		t.Fatal("should be invalid without a channel")
+	}
+
+	o.ChannelId = "123"
+	if err := o.IsValid(); err == nil || err.Id != "model.incoming_hook.channel_id.app_error" {
+		t.Fatal("should be invalid with a malformed channel")
+	}
+
+	o.ChannelId = NewId()
+	if err := o.IsValid(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIncomingWebhookPatch(t *testing.T) {
+	o := IncomingWebhook{
+		Id:            NewId(),
+		CreateAt:      GetMillis(),
+		UpdateAt:      GetMillis(),
+		UserId:        NewId(),
+		ChannelId:     NewId(),
+		TeamId:        NewId(),
+		DisplayName:   "original",
+		Description:   "description",
+		Username:      "username",
+		IconURL:       "http://example.com/icon.png",
+		ChannelLocked: true,
+	}
+	original := o
+
+	o.Patch(&IncomingWebhookPatch{DisplayName: NewString("renamed")})
+	assert.Nil(t, o.IsValid())
+
+	assert.Equal(t, "renamed", o.DisplayName)
+	assert.Equal(t, original.Description, o.Description)
+	assert.Equal(t, original.ChannelId, o.ChannelId)
+	assert.Equal(t, original.Username, o.Username)
+	assert.Equal(t, original.IconURL, o.IconURL)
+	assert.True(t, o.ChannelLocked)
+
+	o.Patch(&IncomingWebhookPatch{DisplayName: NewString(strings.Repeat("a", 65))})
+	if err := o.IsValid(); assert.NotNil(t, err) {
+		assert.Equal(t, "model.incoming_hook.display_name.app_error", err.Id)
+	}
+
+	channelId := NewId()
+	o.Patch(&IncomingWebhookPatch{
+		DisplayName: NewString("display name"),
+		Description: NewString("new description"),
+		ChannelId:   NewString(channelId),
+		Username:    NewString("new username"),
+		IconURL:     NewString("http://example.com/new.png"),
+	})
+	assert.Nil(t, o.IsValid())
+
+	assert.Equal(t, "display name", o.DisplayName)
+	assert.Equal(t, "new description", o.Description)
+	assert.Equal(t, channelId, o.ChannelId)
+	assert.Equal(t, "new username", o.Username)
+	assert.Equal(t, "http://example.com/new.png", o.IconURL)
+	assert.True(t, o.ChannelLocked)
+}
+
+func TestIncomingWebhookPatchJson(t *testing.T) {
+	p := &IncomingWebhookPatch{DisplayName: NewString(NewId()), ChannelId: NewString(NewId())}
+	rp := IncomingWebhookPatchFromJson(strings.NewReader(p.ToJson()))
+
+	assert.Equal(t, p, rp)
+	assert.Nil(t, IncomingWebhookPatchFromJson(strings.NewReader("junk")))
+}
+
 func TestIncomingWebhookPreSave(t *testing.T) {
 	o := IncomingWebhook{}
 	o.PreSave()
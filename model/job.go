@@ -73,6 +73,38 @@ func (j *Job) IsValid() *AppError {
 	return nil
 }
 
+// jobStatusTransitions lists, for each job status, the statuses that a job may move to next.
+var jobStatusTransitions = map[string][]string{
+	JOB_STATUS_PENDING:          {JOB_STATUS_IN_PROGRESS, JOB_STATUS_CANCELED},
+	JOB_STATUS_IN_PROGRESS:      {JOB_STATUS_IN_PROGRESS, JOB_STATUS_SUCCESS, JOB_STATUS_ERROR, JOB_STATUS_CANCEL_REQUESTED},
+	JOB_STATUS_CANCEL_REQUESTED: {JOB_STATUS_CANCELED, JOB_STATUS_ERROR},
+	JOB_STATUS_SUCCESS:          {},
+	JOB_STATUS_ERROR:            {},
+	JOB_STATUS_CANCELED:         {},
+}
+
+// CanTransitionTo reports whether the job may move from its current status to newStatus. Success,
+// error and canceled are final, and a job in progress may be updated again to report progress.
+func (j *Job) CanTransitionTo(newStatus string) bool {
+	for _, status := range jobStatusTransitions[j.Status] {
+		if status == newStatus {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetStatus moves the job to newStatus, returning an error if the transition is not allowed.
+func (j *Job) SetStatus(newStatus string) *AppError {
+	if !j.CanTransitionTo(newStatus) {
+		return NewAppError("Job.SetStatus", "model.job.set_status.invalid_transition.app_error", map[string]interface{}{"From": j.Status, "To": newStatus}, "id="+j.Id, http.StatusBadRequest)
+	}
+
+	j.Status = newStatus
+	return nil
+}
+
 func (js *Job) ToJson() string {
 	b, _ := json.Marshal(js)
 	return string(b)
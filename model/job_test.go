@@ -0,0 +1,56 @@
+// Copyright (c) 2017-present Mattermost, Inc. All Rights Reserved.
+// See License.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobCanTransitionTo(t *testing.T) {
+	for _, tc := range []struct {
+		From     string
+		To       string
+		Expected bool
+	}{
+		{JOB_STATUS_PENDING, JOB_STATUS_IN_PROGRESS, true},
+		{JOB_STATUS_PENDING, JOB_STATUS_CANCELED, true},
+		{JOB_STATUS_PENDING, JOB_STATUS_SUCCESS, false},
+		{JOB_STATUS_IN_PROGRESS, JOB_STATUS_IN_PROGRESS, true},
+		{JOB_STATUS_IN_PROGRESS, JOB_STATUS_SUCCESS, true},
+		{JOB_STATUS_IN_PROGRESS, JOB_STATUS_ERROR, true},
+		{JOB_STATUS_IN_PROGRESS, JOB_STATUS_CANCEL_REQUESTED, true},
+		{JOB_STATUS_IN_PROGRESS, JOB_STATUS_PENDING, false},
+		{JOB_STATUS_CANCEL_REQUESTED, JOB_STATUS_CANCELED, true},
+		{JOB_STATUS_CANCEL_REQUESTED, JOB_STATUS_ERROR, true},
+		{JOB_STATUS_CANCEL_REQUESTED, JOB_STATUS_IN_PROGRESS, false},
+		{JOB_STATUS_SUCCESS, JOB_STATUS_IN_PROGRESS, false},
+		{JOB_STATUS_ERROR, JOB_STATUS_PENDING, false},
+		{JOB_STATUS_CANCELED, JOB_STATUS_IN_PROGRESS, false},
+		{"junk", JOB_STATUS_IN_PROGRESS, false},
+		{JOB_STATUS_PENDING, "junk", false},
+	} {
+		t.Run(tc.From+" to "+tc.To, func(t *testing.T) {
+			job := Job{Status: tc.From}
+			assert.Equal(t, tc.Expected, job.CanTransitionTo(tc.To))
+		})
+	}
+}
+
+func TestJobSetStatus(t *testing.T) {
+	job := Job{Id: NewId(), Status: JOB_STATUS_PENDING}
+
+	require.Nil(t, job.SetStatus(JOB_STATUS_IN_PROGRESS))
+	assert.Equal(t, JOB_STATUS_IN_PROGRESS, job.Status)
+
+	require.Nil(t, job.SetStatus(JOB_STATUS_SUCCESS))
+	assert.Equal(t, JOB_STATUS_SUCCESS, job.Status)
+
+	err := job.SetStatus(JOB_STATUS_IN_PROGRESS)
+	require.NotNil(t, err)
+	assert.Equal(t, "model.job.set_status.invalid_transition.app_error", err.Id)
+	assert.Equal(t, JOB_STATUS_SUCCESS, job.Status)
+}
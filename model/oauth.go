@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 	"unicode/utf8"
 )
 
@@ -125,8 +127,21 @@ func (a *OAuthApp) Sanitize() {
 }
 
 func (a *OAuthApp) IsValidRedirectURL(url string) bool {
-	for _, u := range a.CallbackUrls {
-		if u == url {
+	return a.IsValidRedirectURI(url)
+}
+
+// IsValidRedirectURI reports whether the given redirect URI exactly matches one of the app's
+// registered callback URLs. The scheme and host are compared case-insensitively and default ports
+// and empty paths are normalized, but any other difference, such as an extra path segment, is
+// rejected.
+func (a *OAuthApp) IsValidRedirectURI(uri string) bool {
+	normalized, ok := normalizeRedirectURI(uri)
+	if !ok {
+		return false
+	}
+
+	for _, callback := range a.CallbackUrls {
+		if normalizedCallback, ok := normalizeRedirectURI(callback); ok && normalizedCallback == normalized {
 			return true
 		}
 	}
@@ -134,6 +149,31 @@ func (a *OAuthApp) IsValidRedirectURL(url string) bool {
 	return false
 }
 
+func normalizeRedirectURI(uri string) (string, bool) {
+	u, err := url.Parse(uri)
+	if err != nil || !u.IsAbs() || u.Host == "" || u.User != nil || u.Fragment != "" {
+		return "", false
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Hostname())
+	if port := u.Port(); port != "" && !(scheme == "http" && port == "80") && !(scheme == "https" && port == "443") {
+		host += ":" + port
+	}
+
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	normalized := scheme + "://" + host + path
+	if u.ForceQuery || u.RawQuery != "" {
+		normalized += "?" + u.RawQuery
+	}
+
+	return normalized, true
+}
+
 func OAuthAppFromJson(data io.Reader) *OAuthApp {
 	var app *OAuthApp
 	json.NewDecoder(data).Decode(&app)
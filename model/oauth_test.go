@@ -83,3 +83,37 @@ func TestOAuthAppIsValid(t *testing.T) {
 	app.IconURL = "https://nowhere.com/icon_image.png"
 	require.Nil(t, app.IsValid())
 }
+
+func TestOAuthAppIsValidRedirectURI(t *testing.T) {
+	app := OAuthApp{
+		CallbackUrls: []string{"https://example.com/callback", "http://localhost:8065/oauth/complete?team=a", "https://callback_update.com"},
+	}
+
+	for uri, expected := range map[string]bool{
+		"https://example.com/callback":                 true,
+		"HTTPS://Example.COM/callback":                 true,
+		"https://example.com:443/callback":             true,
+		"http://localhost:8065/oauth/complete?team=a":  true,
+		"https://callback_update.com":                  true,
+		"https://callback_update.com/":                 true,
+		"https://example.com/Callback":                 false,
+		"https://example.com/callback/":                false,
+		"https://example.com/callback/../evil":         false,
+		"https://example.com/callback/%2e%2e/evil":     false,
+		"https://example.com/callback?next=evil":       false,
+		"https://example.com/callback#fragment":        false,
+		"http://example.com/callback":                  false,
+		"https://example.com:8443/callback":            false,
+		"https://example.com.evil.com/callback":        false,
+		"https://user@example.com/callback":            false,
+		"//example.com/callback":                       false,
+		"/callback":                                    false,
+		"http://localhost:8065/oauth/complete?team=b":  false,
+		"http://localhost:8065/oauth/complete":         false,
+		"":                                             false,
+		"http://localhost:8065/oauth/complete?team=a&": false,
+	} {
+		require.Equal(t, expected, app.IsValidRedirectURI(uri), uri)
+		require.Equal(t, expected, app.IsValidRedirectURL(uri), uri)
+	}
+}
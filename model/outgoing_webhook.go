@@ -13,6 +13,11 @@ import (
 	"strings"
 )
 
+const (
+	TRIGGERWORDS_EXACT_MATCH = 0
+	TRIGGERWORDS_STARTS_WITH = 1
+)
+
 type OutgoingWebhook struct {
 	Id           string      `json:"id"`
 	Token        string      `json:"token"`
@@ -239,6 +244,19 @@ func (o *OutgoingWebhook) TriggerWordStartsWith(word string) bool {
 	return false
 }
 
+// TriggerWordMatches returns true if word matches one of the webhook's trigger words using the
+// matching mode selected by TriggerWhen.
+func (o *OutgoingWebhook) TriggerWordMatches(word string) bool {
+	switch o.TriggerWhen {
+	case TRIGGERWORDS_EXACT_MATCH:
+		return o.TriggerWordExactMatch(word)
+	case TRIGGERWORDS_STARTS_WITH:
+		return o.TriggerWordStartsWith(word)
+	}
+
+	return false
+}
+
 func (o *OutgoingWebhook) GetTriggerWord(word string, isExactMatch bool) (triggerWord string) {
 	if len(word) == 0 {
 		return
@@ -197,6 +197,40 @@ func TestOutgoingWebhookTriggerWordStartsWith(t *testing.T) {
 	}
 }
 
+func TestOutgoingWebhookTriggerWordMatches(t *testing.T) {
+	o := OutgoingWebhook{Id: NewId(), TriggerWords: []string{"foo", "bar"}}
+
+	o.TriggerWhen = TRIGGERWORDS_EXACT_MATCH
+	if !o.TriggerWordMatches("bar") {
+		t.Fatal("Should match exactly")
+	}
+	if o.TriggerWordMatches("foobar") {
+		t.Fatal("Should not match a prefix in exact match mode")
+	}
+
+	o.TriggerWhen = TRIGGERWORDS_STARTS_WITH
+	if !o.TriggerWordMatches("foobar") {
+		t.Fatal("Should match a prefix")
+	}
+	if !o.TriggerWordMatches("bar") {
+		t.Fatal("Should match the whole word")
+	}
+	if o.TriggerWordMatches("bazfoo") {
+		t.Fatal("Should only match from the start of the word")
+	}
+
+	o.TriggerWords = nil
+	for _, triggerWhen := range []int{TRIGGERWORDS_EXACT_MATCH, TRIGGERWORDS_STARTS_WITH} {
+		o.TriggerWhen = triggerWhen
+		if o.TriggerWordMatches("foo") {
+			t.Fatal("Should not match without trigger words")
+		}
+		if o.TriggerWordMatches("") {
+			t.Fatal("Should not match an empty word")
+		}
+	}
+}
+
 func TestOutgoingWebhookResponseJson(t *testing.T) {
 	o := OutgoingWebhookResponse{}
 	o.Text = NewString("some text")
@@ -461,6 +461,17 @@ func initializePermissions() {
 	}
 }
 
+// PermissionById returns the permission defined with the given id, if any.
+func PermissionById(id string) (*Permission, bool) {
+	for _, permission := range ALL_PERMISSIONS {
+		if permission.Id == id {
+			return permission, true
+		}
+	}
+
+	return nil, false
+}
+
 func init() {
 	initializePermissions()
 }
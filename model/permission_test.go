@@ -16,3 +16,23 @@ func TestPermissionsLength(t *testing.T) {
 
 	assert.True(t, len(permissionsString) < 4096)
 }
+
+func TestPermissionById(t *testing.T) {
+	permission, ok := PermissionById("create_post")
+	assert.True(t, ok)
+	assert.Equal(t, PERMISSION_CREATE_POST, permission)
+
+	for _, p := range ALL_PERMISSIONS {
+		permission, ok := PermissionById(p.Id)
+		assert.True(t, ok, p.Id)
+		assert.Equal(t, p, permission)
+	}
+
+	permission, ok = PermissionById("create_psot")
+	assert.False(t, ok)
+	assert.Nil(t, permission)
+
+	permission, ok = PermissionById("")
+	assert.False(t, ok)
+	assert.Nil(t, permission)
+}
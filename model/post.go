@@ -5,6 +5,7 @@ package model
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"sort"
@@ -50,6 +51,14 @@ const (
 	PROPS_ADD_CHANNEL_MEMBER    = "add_channel_member"
 	POST_PROPS_ADDED_USER_ID    = "addedUserId"
 	POST_PROPS_DELETE_BY        = "deleteBy"
+
+	POST_PROPS_FROM_WEBHOOK      = "from_webhook"
+	POST_PROPS_OVERRIDE_USERNAME = "override_username"
+	POST_PROPS_OVERRIDE_ICON_URL = "override_icon_url"
+
+	POST_RENDER_CATEGORY_DEFAULT = "default"
+	POST_RENDER_CATEGORY_SYSTEM  = "system"
+	POST_RENDER_CATEGORY_CUSTOM  = "custom"
 )
 
 type Post struct {
@@ -133,9 +142,47 @@ type PostForIndexing struct {
 	ParentCreateAt *int64 `json:"parent_create_at"`
 }
 
-// Clone shallowly copies the post.
+// Clone copies the post, including its Props, Filenames, FileIds and Metadata, so that changes
+// to the copy's maps and slices don't affect the original. Values stored in Props and the
+// elements of the Metadata slices are not themselves copied.
 func (o *Post) Clone() *Post {
 	copy := *o
+
+	if o.Props != nil {
+		copy.Props = make(StringInterface, len(o.Props))
+		for k, v := range o.Props {
+			copy.Props[k] = v
+		}
+	}
+
+	if o.Filenames != nil {
+		copy.Filenames = append(StringArray{}, o.Filenames...)
+	}
+
+	if o.FileIds != nil {
+		copy.FileIds = append(StringArray{}, o.FileIds...)
+	}
+
+	if o.Metadata != nil {
+		copy.Metadata = o.Metadata.clone()
+	}
+
+	return &copy
+}
+
+// ForWebSocket returns a copy of the post suitable for broadcasting over the websocket. Its
+// metadata is reduced to the post's reactions so that embeds, images and file infos, which clients
+// fetch separately, don't inflate the event.
+func (o *Post) ForWebSocket() *Post {
+	copy := *o
+
+	if o.Metadata != nil {
+		copy.Metadata = &PostMetadata{}
+		if o.Metadata.Reactions != nil {
+			copy.Metadata.Reactions = append([]*Reaction{}, o.Metadata.Reactions...)
+		}
+	}
+
 	return &copy
 }
 
@@ -161,6 +208,12 @@ func (o *Post) Etag() string {
 	return Etag(o.Id, o.UpdateAt)
 }
 
+// MessageRuneCount returns the length of the message in runes, which is what IsValid compares
+// against the maximum post size.
+func (o *Post) MessageRuneCount() int {
+	return utf8.RuneCountInString(o.Message)
+}
+
 func (o *Post) IsValid(maxPostSize int) *AppError {
 
 	if len(o.Id) != 26 {
@@ -199,8 +252,8 @@ func (o *Post) IsValid(maxPostSize int) *AppError {
 		return NewAppError("Post.IsValid", "model.post.is_valid.original_id.app_error", nil, "", http.StatusBadRequest)
 	}
 
-	if utf8.RuneCountInString(o.Message) > maxPostSize {
-		return NewAppError("Post.IsValid", "model.post.is_valid.msg.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	if runeCount := o.MessageRuneCount(); runeCount > maxPostSize {
+		return NewAppError("Post.IsValid", "model.post.is_valid.msg.app_error", nil, fmt.Sprintf("id=%v limit=%v over=%v", o.Id, maxPostSize, runeCount-maxPostSize), http.StatusBadRequest)
 	}
 
 	if utf8.RuneCountInString(o.Hashtags) > POST_HASHTAGS_MAX_RUNES {
@@ -235,11 +288,11 @@ func (o *Post) IsValid(maxPostSize int) *AppError {
 		}
 	}
 
-	if utf8.RuneCountInString(ArrayToJson(o.Filenames)) > POST_FILENAMES_MAX_RUNES {
+	if utf8.RuneCountInString(o.Filenames.ToJson()) > POST_FILENAMES_MAX_RUNES {
 		return NewAppError("Post.IsValid", "model.post.is_valid.filenames.app_error", nil, "id="+o.Id, http.StatusBadRequest)
 	}
 
-	if utf8.RuneCountInString(ArrayToJson(o.FileIds)) > POST_FILEIDS_MAX_RUNES {
+	if utf8.RuneCountInString(o.FileIds.ToJson()) > POST_FILEIDS_MAX_RUNES {
 		return NewAppError("Post.IsValid", "model.post.is_valid.file_ids.app_error", nil, "id="+o.Id, http.StatusBadRequest)
 	}
 
@@ -250,6 +303,15 @@ func (o *Post) IsValid(maxPostSize int) *AppError {
 	return nil
 }
 
+// postIntegrationProps are set by the server on posts made by webhooks, slash commands and
+// interactive messages. Users must not be able to set them directly, since they change how a
+// post is displayed and notified.
+var postIntegrationProps = []string{
+	POST_PROPS_FROM_WEBHOOK,
+	POST_PROPS_OVERRIDE_USERNAME,
+	POST_PROPS_OVERRIDE_ICON_URL,
+}
+
 func (o *Post) SanitizeProps() {
 	membersToSanitize := []string{
 		PROPS_ADD_CHANNEL_MEMBER,
@@ -262,6 +324,26 @@ func (o *Post) SanitizeProps() {
 	}
 }
 
+// SanitizeIntegrationProps removes the props reserved for integrations. It should be called on
+// posts submitted by users who aren't authorized to post on behalf of an integration.
+func (o *Post) SanitizeIntegrationProps() {
+	for _, member := range postIntegrationProps {
+		delete(o.Props, member)
+	}
+}
+
+// KeepIntegrationProps replaces the props reserved for integrations with those of original, so
+// that an edit by a user who isn't authorized to set them can't add, change or remove them.
+func (o *Post) KeepIntegrationProps(original *Post) {
+	o.SanitizeIntegrationProps()
+
+	for _, member := range postIntegrationProps {
+		if value, ok := original.Props[member]; ok {
+			o.AddProp(member, value)
+		}
+	}
+}
+
 func (o *Post) PreSave() {
 	if o.Id == "" {
 		o.Id = NewId()
@@ -306,10 +388,69 @@ func (o *Post) AddProp(key string, value interface{}) {
 	o.Props[key] = value
 }
 
+// ReactionCount returns the number of reactions included in the post's metadata. It returns zero if
+// the metadata has not been populated.
+func (o *Post) ReactionCount() int {
+	if o.Metadata == nil {
+		return 0
+	}
+
+	return len(o.Metadata.Reactions)
+}
+
+// AddFileId appends the given file id to the post's FileIds unless it is already attached.
+func (o *Post) AddFileId(id string) {
+	for _, fileId := range o.FileIds {
+		if fileId == id {
+			return
+		}
+	}
+
+	o.FileIds = append(o.FileIds, id)
+}
+
+// RemoveFileId removes every occurrence of the given file id from the post's FileIds.
+func (o *Post) RemoveFileId(id string) {
+	var fileIds StringArray
+	for _, fileId := range o.FileIds {
+		if fileId != id {
+			fileIds = append(fileIds, fileId)
+		}
+	}
+
+	if len(fileIds) != len(o.FileIds) {
+		o.FileIds = fileIds
+	}
+}
+
 func (o *Post) IsSystemMessage() bool {
 	return len(o.Type) >= len(POST_SYSTEM_MESSAGE_PREFIX) && o.Type[:len(POST_SYSTEM_MESSAGE_PREFIX)] == POST_SYSTEM_MESSAGE_PREFIX
 }
 
+// RenderCategory returns how clients should render the post based on its type: as a system
+// message, as a plugin-defined custom post, or as a regular post.
+func (o *Post) RenderCategory() string {
+	if o.IsSystemMessage() {
+		return POST_RENDER_CATEGORY_SYSTEM
+	}
+
+	if strings.HasPrefix(o.Type, POST_CUSTOM_TYPE_PREFIX) {
+		return POST_RENDER_CATEGORY_CUSTOM
+	}
+
+	return POST_RENDER_CATEGORY_DEFAULT
+}
+
+// IsJoinLeaveMessage reports whether the post is a system message generated when a user joins or
+// leaves a channel or team of their own accord.
+func (o *Post) IsJoinLeaveMessage() bool {
+	return o.Type == POST_JOIN_LEAVE ||
+		o.Type == POST_JOIN_CHANNEL ||
+		o.Type == POST_LEAVE_CHANNEL ||
+		o.Type == POST_JOIN_TEAM ||
+		o.Type == POST_LEAVE_TEAM
+}
+
 func (p *Post) Patch(patch *PostPatch) {
 	if patch.IsPinned != nil {
 		p.IsPinned = *patch.IsPinned
@@ -332,6 +473,18 @@ func (p *Post) Patch(patch *PostPatch) {
 	}
 }
 
+// KeepIntegrationProps replaces the props reserved for integrations in the patch with those of
+// original. It has no effect if the patch doesn't change the post's props.
+func (o *PostPatch) KeepIntegrationProps(original *Post) {
+	if o.Props == nil {
+		return
+	}
+
+	post := &Post{Props: *o.Props}
+	post.KeepIntegrationProps(original)
+	o.Props = &post.Props
+}
+
 func (o *PostPatch) ToJson() string {
 	b, err := json.Marshal(o)
 	if err != nil {
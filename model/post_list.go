@@ -5,6 +5,7 @@ package model
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"sort"
 )
@@ -92,6 +93,90 @@ func (o *PostList) Extend(other *PostList) {
 	}
 }
 
+// UniqueUserIds returns the distinct authors of the posts in the list, in the order in which they
+// first appear in Order. Authors of posts that are not part of Order, such as the root posts of
+// threads, follow in order of creation.
+func (o *PostList) UniqueUserIds() []string {
+	userIds := []string{}
+	seen := make(map[string]bool)
+
+	add := func(post *Post) {
+		if post == nil || seen[post.UserId] {
+			return
+		}
+		seen[post.UserId] = true
+		userIds = append(userIds, post.UserId)
+	}
+
+	inOrder := make(map[string]bool, len(o.Order))
+	for _, postId := range o.Order {
+		inOrder[postId] = true
+		add(o.Posts[postId])
+	}
+
+	var others []*Post
+	for postId, post := range o.Posts {
+		if !inOrder[postId] && post != nil {
+			others = append(others, post)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool {
+		if others[i].CreateAt == others[j].CreateAt {
+			return others[i].Id < others[j].Id
+		}
+		return others[i].CreateAt < others[j].CreateAt
+	})
+	for _, post := range others {
+		add(post)
+	}
+
+	return userIds
+}
+
+// ToSlice returns the posts in Order sequence, skipping any ids that are missing from Posts.
+func (o *PostList) ToSlice() []*Post {
+	posts := make([]*Post, 0, len(o.Order))
+	for _, postId := range o.Order {
+		if post, ok := o.Posts[postId]; ok && post != nil {
+			posts = append(posts, post)
+		}
+	}
+	return posts
+}
+
+func (o *PostList) Empty() bool {
+	return len(o.Order) == 0
+}
+
+// NextPageCursor returns a cursor identifying the last post in Order, from which the following
+// (older) page of posts starts. It is empty if the list has no posts.
+func (o *PostList) NextPageCursor() string {
+	if o.Empty() {
+		return ""
+	}
+
+	return o.cursorForPost(o.Order[len(o.Order)-1])
+}
+
+// PrevPageCursor returns a cursor identifying the first post in Order, from which the preceding
+// (newer) page of posts starts. It is empty if the list has no posts.
+func (o *PostList) PrevPageCursor() string {
+	if o.Empty() {
+		return ""
+	}
+
+	return o.cursorForPost(o.Order[0])
+}
+
+func (o *PostList) cursorForPost(postId string) string {
+	post, ok := o.Posts[postId]
+	if !ok || post == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%d:%s", post.CreateAt, post.Id)
+}
+
 func (o *PostList) SortByCreateAt() {
 	sort.Slice(o.Order, func(i, j int) bool {
 		return o.Posts[o.Order[i]].CreateAt > o.Posts[o.Order[j]].CreateAt
@@ -90,3 +90,73 @@ func TestPostListSortByCreateAt(t *testing.T) {
 	assert.EqualValues(t, pl.Order[1], p1.Id)
 	assert.EqualValues(t, pl.Order[2], p2.Id)
 }
+
+func TestPostListUniqueUserIds(t *testing.T) {
+	assert.Equal(t, []string{}, NewPostList().UniqueUserIds())
+	assert.Equal(t, []string{}, (&PostList{}).UniqueUserIds())
+
+	user1 := NewId()
+	user2 := NewId()
+	user3 := NewId()
+
+	pl := NewPostList()
+	p1 := &Post{Id: NewId(), UserId: user2, CreateAt: 3}
+	p2 := &Post{Id: NewId(), UserId: user1, CreateAt: 2}
+	p3 := &Post{Id: NewId(), UserId: user2, CreateAt: 1}
+	root := &Post{Id: NewId(), UserId: user3, CreateAt: 0}
+	for _, p := range []*Post{p1, p2, p3, root} {
+		pl.AddPost(p)
+	}
+	pl.AddOrder(p1.Id)
+	pl.AddOrder(p2.Id)
+	pl.AddOrder(p3.Id)
+
+	assert.Equal(t, []string{user2, user1, user3}, pl.UniqueUserIds())
+}
+
+func TestPostListToSlice(t *testing.T) {
+	assert.Equal(t, []*Post{}, NewPostList().ToSlice())
+	assert.Equal(t, []*Post{}, (&PostList{}).ToSlice())
+
+	pl := NewPostList()
+	p1 := &Post{Id: NewId(), CreateAt: 3}
+	p2 := &Post{Id: NewId(), CreateAt: 2}
+	p3 := &Post{Id: NewId(), CreateAt: 1}
+	root := &Post{Id: NewId(), CreateAt: 0}
+	for _, p := range []*Post{p3, root, p1, p2} {
+		pl.AddPost(p)
+	}
+	pl.AddOrder(p1.Id)
+	pl.AddOrder(p2.Id)
+	pl.AddOrder(p3.Id)
+
+	assert.Equal(t, []*Post{p1, p2, p3}, pl.ToSlice())
+
+	pl.Order = []string{p2.Id, NewId(), p1.Id}
+	assert.Equal(t, []*Post{p2, p1}, pl.ToSlice())
+}
+
+func TestPostListCursors(t *testing.T) {
+	pl := NewPostList()
+	assert.True(t, pl.Empty())
+	assert.Equal(t, "", pl.NextPageCursor())
+	assert.Equal(t, "", pl.PrevPageCursor())
+	assert.True(t, (&PostList{}).Empty())
+
+	p1 := &Post{Id: NewId(), CreateAt: 3}
+	p2 := &Post{Id: NewId(), CreateAt: 2}
+	p3 := &Post{Id: NewId(), CreateAt: 1}
+	for _, p := range []*Post{p1, p2, p3} {
+		pl.AddPost(p)
+		pl.AddOrder(p.Id)
+	}
+
+	assert.False(t, pl.Empty())
+	assert.Equal(t, "3:"+p1.Id, pl.PrevPageCursor())
+	assert.Equal(t, "1:"+p3.Id, pl.NextPageCursor())
+
+	single := NewPostList()
+	single.AddPost(p2)
+	single.AddOrder(p2.Id)
+	assert.Equal(t, single.PrevPageCursor(), single.NextPageCursor())
+}
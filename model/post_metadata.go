@@ -24,6 +24,35 @@ type PostMetadata struct {
 	Reactions []*Reaction `json:"reactions,omitempty"`
 }
 
+func (o *PostMetadata) clone() *PostMetadata {
+	copy := *o
+
+	if o.Embeds != nil {
+		copy.Embeds = append([]*PostEmbed{}, o.Embeds...)
+	}
+
+	if o.Emojis != nil {
+		copy.Emojis = append([]*Emoji{}, o.Emojis...)
+	}
+
+	if o.Files != nil {
+		copy.Files = append([]*FileInfo{}, o.Files...)
+	}
+
+	if o.Images != nil {
+		copy.Images = make(map[string]*PostImage, len(o.Images))
+		for k, v := range o.Images {
+			copy.Images[k] = v
+		}
+	}
+
+	if o.Reactions != nil {
+		copy.Reactions = append([]*Reaction{}, o.Reactions...)
+	}
+
+	return &copy
+}
+
 type PostImage struct {
 	Width  int `json:"width"`
 	Height int `json:"height"`
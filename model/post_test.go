@@ -25,6 +25,97 @@ func TestPostFromJsonError(t *testing.T) {
 	assert.Nil(t, ro)
 }
 
+func TestPostClone(t *testing.T) {
+	o := &Post{
+		Id:      NewId(),
+		Message: "test",
+		Props: StringInterface{
+			"key": "value",
+		},
+		FileIds: StringArray{NewId()},
+		Metadata: &PostMetadata{
+			Reactions: []*Reaction{{EmojiName: "smile"}},
+			Images: map[string]*PostImage{
+				"http://example.com/image.png": {Width: 10, Height: 10},
+			},
+		},
+	}
+
+	copy := o.Clone()
+	assert.Equal(t, o, copy)
+
+	copy.Props["key"] = "other"
+	copy.Props["new"] = "value"
+	copy.FileIds[0] = NewId()
+	copy.Metadata.Reactions = append(copy.Metadata.Reactions, &Reaction{EmojiName: "frowning"})
+	delete(copy.Metadata.Images, "http://example.com/image.png")
+
+	assert.Equal(t, StringInterface{"key": "value"}, o.Props)
+	assert.NotEqual(t, copy.FileIds[0], o.FileIds[0])
+	assert.Len(t, o.Metadata.Reactions, 1)
+	assert.Len(t, o.Metadata.Images, 1)
+
+	assert.Nil(t, (&Post{}).Clone().Props)
+	assert.Nil(t, (&Post{}).Clone().Metadata)
+}
+
+func TestPostForWebSocket(t *testing.T) {
+	post := &Post{
+		Id:      NewId(),
+		Message: "message",
+		Metadata: &PostMetadata{
+			Embeds:    []*PostEmbed{{Type: POST_EMBED_OPENGRAPH, URL: "https://example.com"}},
+			Emojis:    []*Emoji{{Id: NewId(), Name: "emoji"}},
+			Files:     []*FileInfo{{Id: NewId()}},
+			Images:    map[string]*PostImage{"https://example.com/image.png": {Width: 10, Height: 10}},
+			Reactions: []*Reaction{{EmojiName: "smile"}},
+		},
+	}
+
+	wsPost := post.ForWebSocket()
+	assert.Equal(t, post.Id, wsPost.Id)
+	assert.Equal(t, post.Message, wsPost.Message)
+	assert.Nil(t, wsPost.Metadata.Embeds)
+	assert.Nil(t, wsPost.Metadata.Emojis)
+	assert.Nil(t, wsPost.Metadata.Files)
+	assert.Nil(t, wsPost.Metadata.Images)
+	assert.Equal(t, post.Metadata.Reactions, wsPost.Metadata.Reactions)
+
+	// The original post is left untouched
+	assert.Len(t, post.Metadata.Embeds, 1)
+	assert.Len(t, post.Metadata.Images, 1)
+
+	assert.Nil(t, (&Post{Id: NewId()}).ForWebSocket().Metadata)
+}
+
+func TestPostMessageRuneCount(t *testing.T) {
+	assert.Equal(t, 0, (&Post{}).MessageRuneCount())
+	assert.Equal(t, 5, (&Post{Message: "hello"}).MessageRuneCount())
+	assert.Equal(t, 4, (&Post{Message: "日本語!"}).MessageRuneCount())
+}
+
+func TestPostIsValidMessageTooLong(t *testing.T) {
+	o := Post{
+		Id:        NewId(),
+		CreateAt:  GetMillis(),
+		UpdateAt:  GetMillis(),
+		UserId:    NewId(),
+		ChannelId: NewId(),
+		Message:   strings.Repeat("é", 100),
+	}
+
+	assert.Nil(t, o.IsValid(100))
+
+	o.Message = strings.Repeat("é", 142)
+	err := o.IsValid(100)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "model.post.is_valid.msg.app_error", err.Id)
+		assert.Contains(t, err.DetailedError, "id="+o.Id)
+		assert.Contains(t, err.DetailedError, "limit=100")
+		assert.Contains(t, err.DetailedError, "over=42")
+	}
+}
+
 func TestPostIsValid(t *testing.T) {
 	o := Post{}
 	maxPostSize := 10000
@@ -98,6 +189,36 @@ func TestPostIsValid(t *testing.T) {
 	}
 }
 
+func TestPostIsValidThreadConsistency(t *testing.T) {
+	maxPostSize := 10000
+	o := Post{
+		Id:        NewId(),
+		CreateAt:  GetMillis(),
+		UpdateAt:  GetMillis(),
+		UserId:    NewId(),
+		ChannelId: NewId(),
+		Message:   "reply",
+	}
+
+	t.Run("parent without root", func(t *testing.T) {
+		orphan := o
+		orphan.ParentId = NewId()
+
+		err := orphan.IsValid(maxPostSize)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "model.post.is_valid.root_parent.app_error", err.Id)
+		}
+	})
+
+	t.Run("reply with root and parent", func(t *testing.T) {
+		reply := o
+		reply.RootId = NewId()
+		reply.ParentId = reply.RootId
+
+		assert.Nil(t, reply.IsValid(maxPostSize))
+	})
+}
+
 func TestPostPreSave(t *testing.T) {
 	o := Post{Message: "test"}
 	o.PreSave()
@@ -117,6 +238,48 @@ func TestPostPreSave(t *testing.T) {
 	o.Etag()
 }
 
+func TestPostReactionCount(t *testing.T) {
+	o := Post{}
+	assert.Equal(t, 0, o.ReactionCount())
+
+	o.Metadata = &PostMetadata{}
+	assert.Equal(t, 0, o.ReactionCount())
+
+	o.Metadata.Reactions = []*Reaction{{EmojiName: "smile"}}
+	assert.Equal(t, 1, o.ReactionCount())
+
+	o.Metadata.Reactions = append(o.Metadata.Reactions, &Reaction{EmojiName: "frown"}, &Reaction{EmojiName: "+1"})
+	assert.Equal(t, 3, o.ReactionCount())
+}
+
+func TestPostAddFileId(t *testing.T) {
+	o := Post{}
+	o.AddFileId("a")
+	o.AddFileId("b")
+	assert.Equal(t, StringArray{"a", "b"}, o.FileIds)
+
+	o.AddFileId("a")
+	assert.Equal(t, StringArray{"a", "b"}, o.FileIds)
+}
+
+func TestPostRemoveFileId(t *testing.T) {
+	o := Post{FileIds: StringArray{"a", "b", "c"}}
+
+	o.RemoveFileId("d")
+	assert.Equal(t, StringArray{"a", "b", "c"}, o.FileIds)
+
+	o.RemoveFileId("b")
+	assert.Equal(t, StringArray{"a", "c"}, o.FileIds)
+
+	o.RemoveFileId("a")
+	o.RemoveFileId("c")
+	assert.Empty(t, o.FileIds)
+
+	empty := Post{}
+	empty.RemoveFileId("a")
+	assert.Nil(t, empty.FileIds)
+}
+
 func TestPostIsSystemMessage(t *testing.T) {
 	post1 := Post{Message: "test_1"}
 	post1.PreSave()
@@ -132,6 +295,45 @@ func TestPostIsSystemMessage(t *testing.T) {
 	}
 }
 
+func TestPostRenderCategory(t *testing.T) {
+	for postType, expected := range map[string]string{
+		POST_DEFAULT:                     POST_RENDER_CATEGORY_DEFAULT,
+		POST_SLACK_ATTACHMENT:            POST_RENDER_CATEGORY_DEFAULT,
+		POST_JOIN_CHANNEL:                POST_RENDER_CATEGORY_SYSTEM,
+		POST_HEADER_CHANGE:               POST_RENDER_CATEGORY_SYSTEM,
+		POST_EPHEMERAL:                   POST_RENDER_CATEGORY_SYSTEM,
+		POST_CUSTOM_TYPE_PREFIX + "poll": POST_RENDER_CATEGORY_CUSTOM,
+		"system":                         POST_RENDER_CATEGORY_DEFAULT,
+	} {
+		post := Post{Type: postType}
+		assert.Equal(t, expected, post.RenderCategory(), postType)
+	}
+}
+
+func TestPostIsJoinLeaveMessage(t *testing.T) {
+	for _, tc := range []struct {
+		Type            string
+		IsSystemMessage bool
+		IsJoinLeave     bool
+	}{
+		{POST_DEFAULT, false, false},
+		{POST_SLACK_ATTACHMENT, false, false},
+		{POST_JOIN_CHANNEL, true, true},
+		{POST_LEAVE_CHANNEL, true, true},
+		{POST_JOIN_TEAM, true, true},
+		{POST_LEAVE_TEAM, true, true},
+		{POST_JOIN_LEAVE, true, true},
+		{POST_ADD_TO_CHANNEL, true, false},
+		{POST_HEADER_CHANGE, true, false},
+	} {
+		t.Run(tc.Type, func(t *testing.T) {
+			post := Post{Type: tc.Type}
+			assert.Equal(t, tc.IsSystemMessage, post.IsSystemMessage())
+			assert.Equal(t, tc.IsJoinLeave, post.IsJoinLeaveMessage())
+		})
+	}
+}
+
 func TestPostChannelMentions(t *testing.T) {
 	post := Post{Message: "~a ~b ~b ~c/~d."}
 	assert.Equal(t, []string{"a", "b", "c", "d"}, post.ChannelMentions())
@@ -180,6 +382,79 @@ func TestPostSanitizeProps(t *testing.T) {
 	}
 }
 
+func TestPostSanitizeIntegrationProps(t *testing.T) {
+	post := &Post{
+		Message: "test",
+		Props: StringInterface{
+			POST_PROPS_FROM_WEBHOOK:      "true",
+			POST_PROPS_OVERRIDE_USERNAME: "someone else",
+			POST_PROPS_OVERRIDE_ICON_URL: "http://example.com/icon.png",
+			"attachments":                "good",
+			POST_PROPS_ADDED_USER_ID:     "good",
+		},
+	}
+
+	post.SanitizeIntegrationProps()
+
+	assert.Equal(t, StringInterface{
+		"attachments":            "good",
+		POST_PROPS_ADDED_USER_ID: "good",
+	}, post.Props)
+
+	post = &Post{Message: "test"}
+	post.SanitizeIntegrationProps()
+	assert.Nil(t, post.Props)
+}
+
+func TestPostKeepIntegrationProps(t *testing.T) {
+	original := &Post{
+		Props: StringInterface{
+			POST_PROPS_FROM_WEBHOOK:      "true",
+			POST_PROPS_OVERRIDE_USERNAME: "webhook",
+			"attachments":                "old",
+		},
+	}
+
+	post := &Post{
+		Props: StringInterface{
+			POST_PROPS_OVERRIDE_USERNAME: "impersonated",
+			POST_PROPS_OVERRIDE_ICON_URL: "http://example.com/icon.png",
+			"attachments":                "new",
+		},
+	}
+	post.KeepIntegrationProps(original)
+
+	assert.Equal(t, StringInterface{
+		POST_PROPS_FROM_WEBHOOK:      "true",
+		POST_PROPS_OVERRIDE_USERNAME: "webhook",
+		"attachments":                "new",
+	}, post.Props)
+
+	post = &Post{Props: StringInterface{POST_PROPS_FROM_WEBHOOK: "true", "attachments": "new"}}
+	post.KeepIntegrationProps(&Post{})
+	assert.Equal(t, StringInterface{"attachments": "new"}, post.Props)
+
+	post = &Post{}
+	post.KeepIntegrationProps(original)
+	assert.Equal(t, "true", post.Props[POST_PROPS_FROM_WEBHOOK])
+
+	patch := &PostPatch{}
+	patch.KeepIntegrationProps(original)
+	assert.Nil(t, patch.Props)
+
+	patch = &PostPatch{Props: &StringInterface{POST_PROPS_FROM_WEBHOOK: "false", "attachments": "new"}}
+	patch.KeepIntegrationProps(&Post{})
+	assert.Equal(t, StringInterface{"attachments": "new"}, *patch.Props)
+
+	patch = &PostPatch{Props: &StringInterface{"attachments": "new"}}
+	patch.KeepIntegrationProps(original)
+	assert.Equal(t, StringInterface{
+		POST_PROPS_FROM_WEBHOOK:      "true",
+		POST_PROPS_OVERRIDE_USERNAME: "webhook",
+		"attachments":                "new",
+	}, *patch.Props)
+}
+
 var markdownSample, markdownSampleWithRewrittenImageURLs string
 
 func init() {
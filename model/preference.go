@@ -20,6 +20,16 @@ const (
 	PREFERENCE_CATEGORY_FAVORITE_CHANNEL    = "favorite_channel"
 	PREFERENCE_CATEGORY_SIDEBAR_SETTINGS    = "sidebar_settings"
 
+	// Categories used only by clients
+	PREFERENCE_CATEGORY_GROUP_CHANNEL_SHOW            = "group_channel_show"
+	PREFERENCE_CATEGORY_CHANNEL_OPEN_TIME             = "channel_open_time"
+	PREFERENCE_CATEGORY_CHANNEL_APPROXIMATE_VIEW_TIME = "channel_approximate_view_time"
+	PREFERENCE_CATEGORY_AUTO_RESET_MANUAL_STATUS      = "auto_reset_manual_status"
+	PREFERENCE_CATEGORY_RECENT_EMOJIS                 = "recent_emojis"
+
+	// Plugins may store preferences in any category starting with this prefix
+	PREFERENCE_CATEGORY_PLUGIN_PREFIX = "pp_"
+
 	PREFERENCE_CATEGORY_DISPLAY_SETTINGS = "display_settings"
 	PREFERENCE_NAME_CHANNEL_DISPLAY_MODE = "channel_display_mode"
 	PREFERENCE_NAME_COLLAPSE_SETTING     = "collapse_previews"
@@ -49,6 +59,31 @@ const (
 	PREFERENCE_EMAIL_INTERVAL_HOUR_AS_SECONDS     = "3600"
 )
 
+var knownPreferenceCategories = map[string]bool{
+	PREFERENCE_CATEGORY_DIRECT_CHANNEL_SHOW:           true,
+	PREFERENCE_CATEGORY_TUTORIAL_STEPS:                true,
+	PREFERENCE_CATEGORY_ADVANCED_SETTINGS:             true,
+	PREFERENCE_CATEGORY_FLAGGED_POST:                  true,
+	PREFERENCE_CATEGORY_FAVORITE_CHANNEL:              true,
+	PREFERENCE_CATEGORY_SIDEBAR_SETTINGS:              true,
+	PREFERENCE_CATEGORY_DISPLAY_SETTINGS:              true,
+	PREFERENCE_CATEGORY_THEME:                         true,
+	PREFERENCE_CATEGORY_AUTHORIZED_OAUTH_APP:          true,
+	PREFERENCE_CATEGORY_LAST:                          true,
+	PREFERENCE_CATEGORY_NOTIFICATIONS:                 true,
+	PREFERENCE_CATEGORY_GROUP_CHANNEL_SHOW:            true,
+	PREFERENCE_CATEGORY_CHANNEL_OPEN_TIME:             true,
+	PREFERENCE_CATEGORY_CHANNEL_APPROXIMATE_VIEW_TIME: true,
+	PREFERENCE_CATEGORY_AUTO_RESET_MANUAL_STATUS:      true,
+	PREFERENCE_CATEGORY_RECENT_EMOJIS:                 true,
+}
+
+// IsValidPreferenceCategory returns true if the category is one of the known preference categories
+// or belongs to a plugin.
+func IsValidPreferenceCategory(category string) bool {
+	return knownPreferenceCategories[category] || (strings.HasPrefix(category, PREFERENCE_CATEGORY_PLUGIN_PREFIX) && len(category) > len(PREFERENCE_CATEGORY_PLUGIN_PREFIX))
+}
+
 type Preference struct {
 	UserId   string `json:"user_id"`
 	Category string `json:"category"`
@@ -76,6 +111,10 @@ func (o *Preference) IsValid() *AppError {
 		return NewAppError("Preference.IsValid", "model.preference.is_valid.category.app_error", nil, "category="+o.Category, http.StatusBadRequest)
 	}
 
+	if !IsValidPreferenceCategory(o.Category) {
+		return NewAppError("Preference.IsValid", "model.preference.is_valid.unknown_category.app_error", nil, "category="+o.Category, http.StatusBadRequest)
+	}
+
 	if len(o.Name) > 32 {
 		return NewAppError("Preference.IsValid", "model.preference.is_valid.name.app_error", nil, "name="+o.Name, http.StatusBadRequest)
 	}
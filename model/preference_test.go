@@ -48,6 +48,31 @@ func TestPreferenceIsValid(t *testing.T) {
 	require.Nil(t, preference.IsValid())
 }
 
+func TestPreferenceIsValidCategory(t *testing.T) {
+	preference := Preference{
+		UserId: NewId(),
+		Name:   NewId(),
+		Value:  "true",
+	}
+
+	preference.Category = PREFERENCE_CATEGORY_FLAGGED_POST
+	require.Nil(t, preference.IsValid())
+
+	preference.Category = PREFERENCE_CATEGORY_GROUP_CHANNEL_SHOW
+	require.Nil(t, preference.IsValid())
+
+	preference.Category = PREFERENCE_CATEGORY_PLUGIN_PREFIX + "com.example.plugin"
+	require.Nil(t, preference.IsValid())
+
+	preference.Category = PREFERENCE_CATEGORY_PLUGIN_PREFIX
+	require.NotNil(t, preference.IsValid())
+
+	preference.Category = "flaged_post"
+	err := preference.IsValid()
+	require.NotNil(t, err)
+	require.Equal(t, "model.preference.is_valid.unknown_category.app_error", err.Id)
+}
+
 func TestPreferencePreUpdate(t *testing.T) {
 	preference := Preference{
 		Category: PREFERENCE_CATEGORY_THEME,
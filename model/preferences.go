@@ -10,6 +10,30 @@ import (
 
 type Preferences []Preference
 
+// Get returns the value of the preference with the given category and name, if there is one.
+func (o Preferences) Get(category, name string) (string, bool) {
+	for _, preference := range o {
+		if preference.Category == category && preference.Name == name {
+			return preference.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// Upsert replaces the preference with the same category and name as the given one, or adds it
+// if there isn't one.
+func (o *Preferences) Upsert(pref Preference) {
+	for i, preference := range *o {
+		if preference.Category == pref.Category && preference.Name == pref.Name {
+			(*o)[i] = pref
+			return
+		}
+	}
+
+	*o = append(*o, pref)
+}
+
 func (o *Preferences) ToJson() string {
 	b, _ := json.Marshal(o)
 	return string(b)
@@ -0,0 +1,48 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See License.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreferencesGet(t *testing.T) {
+	userId := NewId()
+	preferences := Preferences{
+		{UserId: userId, Category: PREFERENCE_CATEGORY_DISPLAY_SETTINGS, Name: PREFERENCE_NAME_USE_MILITARY_TIME, Value: "true"},
+		{UserId: userId, Category: PREFERENCE_CATEGORY_DISPLAY_SETTINGS, Name: PREFERENCE_NAME_NAME_FORMAT, Value: "username"},
+	}
+
+	value, ok := preferences.Get(PREFERENCE_CATEGORY_DISPLAY_SETTINGS, PREFERENCE_NAME_NAME_FORMAT)
+	assert.True(t, ok)
+	assert.Equal(t, "username", value)
+
+	value, ok = preferences.Get(PREFERENCE_CATEGORY_DISPLAY_SETTINGS, PREFERENCE_NAME_COLLAPSE_SETTING)
+	assert.False(t, ok)
+	assert.Equal(t, "", value)
+
+	value, ok = Preferences(nil).Get(PREFERENCE_CATEGORY_DISPLAY_SETTINGS, PREFERENCE_NAME_NAME_FORMAT)
+	assert.False(t, ok)
+	assert.Equal(t, "", value)
+}
+
+func TestPreferencesUpsert(t *testing.T) {
+	userId := NewId()
+	preferences := Preferences{
+		{UserId: userId, Category: PREFERENCE_CATEGORY_DISPLAY_SETTINGS, Name: PREFERENCE_NAME_USE_MILITARY_TIME, Value: "true"},
+	}
+
+	preferences.Upsert(Preference{UserId: userId, Category: PREFERENCE_CATEGORY_DISPLAY_SETTINGS, Name: PREFERENCE_NAME_USE_MILITARY_TIME, Value: "false"})
+	assert.Len(t, preferences, 1)
+	assert.Equal(t, "false", preferences[0].Value)
+
+	preferences.Upsert(Preference{UserId: userId, Category: PREFERENCE_CATEGORY_DISPLAY_SETTINGS, Name: PREFERENCE_NAME_NAME_FORMAT, Value: "username"})
+	assert.Len(t, preferences, 2)
+
+	value, ok := preferences.Get(PREFERENCE_CATEGORY_DISPLAY_SETTINGS, PREFERENCE_NAME_NAME_FORMAT)
+	assert.True(t, ok)
+	assert.Equal(t, "username", value)
+}
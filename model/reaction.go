@@ -10,6 +10,10 @@ import (
 	"regexp"
 )
 
+var validReactionEmojiName = regexp.MustCompile(`^[a-zA-Z0-9\-\+_]+$`)
+
+type ReactionList []*Reaction
+
 type Reaction struct {
 	UserId    string `json:"user_id"`
 	PostId    string `json:"post_id"`
@@ -47,6 +51,18 @@ func ReactionsFromJson(data io.Reader) []*Reaction {
 	}
 }
 
+func (o ReactionList) Etag() string {
+	var t int64 = 0
+
+	for _, v := range o {
+		if v.CreateAt > t {
+			t = v.CreateAt
+		}
+	}
+
+	return Etag(len(o), t)
+}
+
 func (o *Reaction) IsValid() *AppError {
 	if len(o.UserId) != 26 {
 		return NewAppError("Reaction.IsValid", "model.reaction.is_valid.user_id.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
@@ -56,9 +72,7 @@ func (o *Reaction) IsValid() *AppError {
 		return NewAppError("Reaction.IsValid", "model.reaction.is_valid.post_id.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
 	}
 
-	validName := regexp.MustCompile(`^[a-zA-Z0-9\-\+_]+$`)
-
-	if len(o.EmojiName) == 0 || len(o.EmojiName) > EMOJI_NAME_MAX_LENGTH || !validName.MatchString(o.EmojiName) {
+	if len(o.EmojiName) == 0 || len(o.EmojiName) > EMOJI_NAME_MAX_LENGTH || !validReactionEmojiName.MatchString(o.EmojiName) {
 		return NewAppError("Reaction.IsValid", "model.reaction.is_valid.emoji_name.app_error", nil, "emoji_name="+o.EmojiName, http.StatusBadRequest)
 	}
 
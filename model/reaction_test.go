@@ -67,6 +67,16 @@ func TestReactionIsValid(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	reaction.EmojiName = ":emoji"
+	if err := reaction.IsValid(); err == nil || err.Id != "model.reaction.is_valid.emoji_name.app_error" {
+		t.Fatal("emoji name should be invalid")
+	}
+
+	reaction.EmojiName = "emoji name"
+	if err := reaction.IsValid(); err == nil || err.Id != "model.reaction.is_valid.emoji_name.app_error" {
+		t.Fatal("emoji name should be invalid")
+	}
+
 	reaction.EmojiName = ""
 	if err := reaction.IsValid(); err == nil {
 		t.Fatal("emoji name should be invalid")
@@ -82,3 +92,37 @@ func TestReactionIsValid(t *testing.T) {
 		t.Fatal("create at should be invalid")
 	}
 }
+
+func TestReactionPreSave(t *testing.T) {
+	reaction := Reaction{}
+	reaction.PreSave()
+	if reaction.CreateAt == 0 {
+		t.Fatal("create at should have been set")
+	}
+
+	reaction.CreateAt = 1234
+	reaction.PreSave()
+	if reaction.CreateAt != 1234 {
+		t.Fatal("create at should not have been changed")
+	}
+}
+
+func TestReactionListEtag(t *testing.T) {
+	list := ReactionList{}
+	emptyEtag := list.Etag()
+
+	list = append(list, &Reaction{UserId: NewId(), PostId: NewId(), EmojiName: "smile", CreateAt: 1000})
+	etag := list.Etag()
+	if etag == emptyEtag {
+		t.Fatal("etag should have changed when a reaction was added")
+	}
+
+	list = append(list, &Reaction{UserId: NewId(), PostId: NewId(), EmojiName: "frown", CreateAt: 1000})
+	if list.Etag() == etag {
+		t.Fatal("etag should have changed when a reaction with the same create at was added")
+	}
+
+	if list.Etag() != list.Etag() {
+		t.Fatal("etag should be stable")
+	}
+}
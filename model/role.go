@@ -120,6 +120,58 @@ func PermissionsChangedByPatch(role *Role, patch *RolePatch) []string {
 	return result
 }
 
+// AddPermission grants the given permission to the role unless it already has it.
+func (role *Role) AddPermission(permission string) {
+	for _, p := range role.Permissions {
+		if p == permission {
+			return
+		}
+	}
+
+	role.Permissions = append(role.Permissions, permission)
+}
+
+// RemovePermission revokes the given permission from the role. It does nothing if the role doesn't
+// have the permission.
+func (role *Role) RemovePermission(permission string) {
+	var permissions []string
+	for _, p := range role.Permissions {
+		if p != permission {
+			permissions = append(permissions, p)
+		}
+	}
+
+	if len(permissions) != len(role.Permissions) {
+		role.Permissions = permissions
+	}
+}
+
+// PermissionDiff compares the role's permissions with those of other, returning the permissions that
+// other has but the role doesn't as added, and those the role has but other doesn't as removed.
+func (role *Role) PermissionDiff(other *Role) (added, removed []string) {
+	rolePermissions := make(map[string]bool, len(role.Permissions))
+	for _, permission := range role.Permissions {
+		rolePermissions[permission] = true
+	}
+
+	otherPermissions := make(map[string]bool, len(other.Permissions))
+	for _, permission := range other.Permissions {
+		if !rolePermissions[permission] && !otherPermissions[permission] {
+			added = append(added, permission)
+		}
+		otherPermissions[permission] = true
+	}
+
+	for _, permission := range role.Permissions {
+		if !otherPermissions[permission] {
+			removed = append(removed, permission)
+			otherPermissions[permission] = true
+		}
+	}
+
+	return added, removed
+}
+
 func (role *Role) IsValid() bool {
 	if len(role.Id) != 26 {
 		return false
@@ -142,15 +194,7 @@ func (role *Role) IsValidWithoutId() bool {
 	}
 
 	for _, permission := range role.Permissions {
-		permissionValidated := false
-		for _, p := range ALL_PERMISSIONS {
-			if permission == p.Id {
-				permissionValidated = true
-				break
-			}
-		}
-
-		if !permissionValidated {
+		if _, ok := PermissionById(permission); !ok {
 			return false
 		}
 	}
@@ -0,0 +1,47 @@
+// Copyright (c) 2016-present Mattermost, Inc. All Rights Reserved.
+// See License.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleAddPermission(t *testing.T) {
+	role := &Role{}
+
+	role.AddPermission(PERMISSION_CREATE_POST.Id)
+	role.AddPermission(PERMISSION_EDIT_POST.Id)
+	assert.Equal(t, []string{PERMISSION_CREATE_POST.Id, PERMISSION_EDIT_POST.Id}, role.Permissions)
+
+	role.AddPermission(PERMISSION_CREATE_POST.Id)
+	assert.Equal(t, []string{PERMISSION_CREATE_POST.Id, PERMISSION_EDIT_POST.Id}, role.Permissions)
+}
+
+func TestRoleRemovePermission(t *testing.T) {
+	role := &Role{Permissions: []string{PERMISSION_CREATE_POST.Id, PERMISSION_EDIT_POST.Id}}
+
+	role.RemovePermission(PERMISSION_DELETE_POST.Id)
+	assert.Equal(t, []string{PERMISSION_CREATE_POST.Id, PERMISSION_EDIT_POST.Id}, role.Permissions)
+
+	role.RemovePermission(PERMISSION_CREATE_POST.Id)
+	assert.Equal(t, []string{PERMISSION_EDIT_POST.Id}, role.Permissions)
+
+	role.RemovePermission(PERMISSION_EDIT_POST.Id)
+	assert.Empty(t, role.Permissions)
+}
+
+func TestRolePermissionDiff(t *testing.T) {
+	role := &Role{Permissions: []string{PERMISSION_CREATE_POST.Id, PERMISSION_EDIT_POST.Id, PERMISSION_DELETE_POST.Id}}
+	other := &Role{Permissions: []string{PERMISSION_EDIT_POST.Id, PERMISSION_ADD_REACTION.Id, PERMISSION_ADD_REACTION.Id, PERMISSION_REMOVE_REACTION.Id}}
+
+	added, removed := role.PermissionDiff(other)
+	assert.Equal(t, []string{PERMISSION_ADD_REACTION.Id, PERMISSION_REMOVE_REACTION.Id}, added)
+	assert.Equal(t, []string{PERMISSION_CREATE_POST.Id, PERMISSION_DELETE_POST.Id}, removed)
+
+	added, removed = role.PermissionDiff(role)
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+}
@@ -6,7 +6,9 @@ package model
 import (
 	"encoding/json"
 	"io"
+	"math"
 	"strings"
+	"time"
 )
 
 const (
@@ -100,6 +102,37 @@ func (me *Session) IsExpired() bool {
 	return false
 }
 
+// TimeUntilExpiry returns how long remains before the session expires, or zero if it already has.
+// Sessions that never expire return the maximum representable duration.
+func (me *Session) TimeUntilExpiry() time.Duration {
+	if me.ExpiresAt <= 0 {
+		return time.Duration(math.MaxInt64)
+	}
+
+	remaining := me.ExpiresAt - GetMillis()
+	if remaining <= 0 {
+		return 0
+	}
+
+	return time.Duration(remaining) * time.Millisecond
+}
+
+// DaysUntilExpiry returns the number of days, rounded up, before the session expires, or zero if it
+// already has. Sessions that never expire return -1.
+func (me *Session) DaysUntilExpiry() int {
+	if me.ExpiresAt <= 0 {
+		return -1
+	}
+
+	remaining := me.ExpiresAt - GetMillis()
+	if remaining <= 0 {
+		return 0
+	}
+
+	day := int64(1000 * 60 * 60 * 24)
+	return int((remaining + day - 1) / day)
+}
+
 func (me *Session) SetExpireInDays(days int) {
 	if me.CreateAt == 0 {
 		me.ExpiresAt = GetMillis() + (1000 * 60 * 60 * 24 * int64(days))
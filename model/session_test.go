@@ -4,6 +4,7 @@
 package model
 
 import (
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -64,6 +65,47 @@ func TestSessionJson(t *testing.T) {
 	session.SetExpireInDays(10)
 }
 
+func TestSessionExpiry(t *testing.T) {
+	t.Run("never expires", func(t *testing.T) {
+		session := Session{}
+		assert.False(t, session.IsExpired())
+		assert.Equal(t, time.Duration(math.MaxInt64), session.TimeUntilExpiry())
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		session := Session{ExpiresAt: GetMillis() - 1000}
+		assert.True(t, session.IsExpired())
+		assert.Equal(t, time.Duration(0), session.TimeUntilExpiry())
+	})
+
+	t.Run("not yet expired", func(t *testing.T) {
+		session := Session{ExpiresAt: GetMillis() + 60*60*1000}
+		assert.False(t, session.IsExpired())
+
+		remaining := session.TimeUntilExpiry()
+		assert.True(t, remaining > 59*time.Minute)
+		assert.True(t, remaining <= time.Hour)
+	})
+}
+
+func TestSessionDaysUntilExpiry(t *testing.T) {
+	session := Session{}
+	assert.Equal(t, -1, session.DaysUntilExpiry())
+
+	session.ExpiresAt = GetMillis() - 1000
+	assert.Equal(t, 0, session.DaysUntilExpiry())
+
+	session.ExpiresAt = GetMillis() + 60*60*1000
+	assert.Equal(t, 1, session.DaysUntilExpiry())
+
+	session = Session{CreateAt: GetMillis()}
+	session.SetExpireInDays(30)
+	assert.Equal(t, 30, session.DaysUntilExpiry())
+
+	session.ExpiresAt += 1000
+	assert.Equal(t, 31, session.DaysUntilExpiry())
+}
+
 func TestSessionCSRF(t *testing.T) {
 	s := Session{}
 	token := s.GetCSRF()
@@ -5,7 +5,15 @@ package model
 
 import (
 	"fmt"
+	"net/http"
 	"regexp"
+	"unicode/utf8"
+)
+
+const (
+	SLACK_ATTACHMENT_MAX_FIELDS      = 100
+	SLACK_ATTACHMENT_TITLE_MAX_RUNES = 1024
+	SLACK_ATTACHMENT_TEXT_MAX_RUNES  = POST_MESSAGE_MAX_RUNES_V2
 )
 
 var linkWithTextRegex = regexp.MustCompile(`<([^<\|]+)\|([^>]+)>`)
@@ -36,6 +44,22 @@ type SlackAttachmentField struct {
 	Short bool        `json:"short"`
 }
 
+func (o *SlackAttachment) IsValid() *AppError {
+	if len(o.Fields) > SLACK_ATTACHMENT_MAX_FIELDS {
+		return NewAppError("SlackAttachment.IsValid", "model.slack_attachment.is_valid.fields.app_error", map[string]interface{}{"Max": SLACK_ATTACHMENT_MAX_FIELDS}, fmt.Sprintf("fields=%d", len(o.Fields)), http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(o.Title) > SLACK_ATTACHMENT_TITLE_MAX_RUNES {
+		return NewAppError("SlackAttachment.IsValid", "model.slack_attachment.is_valid.title.app_error", map[string]interface{}{"Max": SLACK_ATTACHMENT_TITLE_MAX_RUNES}, "", http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(o.Text) > SLACK_ATTACHMENT_TEXT_MAX_RUNES {
+		return NewAppError("SlackAttachment.IsValid", "model.slack_attachment.is_valid.text.app_error", map[string]interface{}{"Max": SLACK_ATTACHMENT_TEXT_MAX_RUNES}, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
 func StringifySlackFieldValue(a []*SlackAttachment) []*SlackAttachment {
 	var nonNilAttachments []*SlackAttachment
 	for _, attachment := range a {
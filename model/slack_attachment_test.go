@@ -0,0 +1,42 @@
+// Copyright (c) 2017-present Mattermost, Inc. All Rights Reserved.
+// See License.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlackAttachmentIsValid(t *testing.T) {
+	attachment := SlackAttachment{}
+	assert.Nil(t, attachment.IsValid())
+
+	for i := 0; i < SLACK_ATTACHMENT_MAX_FIELDS; i++ {
+		attachment.Fields = append(attachment.Fields, &SlackAttachmentField{Title: "title", Value: "value"})
+	}
+	attachment.Title = strings.Repeat("é", SLACK_ATTACHMENT_TITLE_MAX_RUNES)
+	attachment.Text = strings.Repeat("é", SLACK_ATTACHMENT_TEXT_MAX_RUNES)
+	assert.Nil(t, attachment.IsValid())
+
+	tooManyFields := attachment
+	tooManyFields.Fields = append(tooManyFields.Fields, &SlackAttachmentField{})
+	err := tooManyFields.IsValid()
+	require.NotNil(t, err)
+	assert.Equal(t, "model.slack_attachment.is_valid.fields.app_error", err.Id)
+
+	titleTooLong := attachment
+	titleTooLong.Title += "a"
+	err = titleTooLong.IsValid()
+	require.NotNil(t, err)
+	assert.Equal(t, "model.slack_attachment.is_valid.title.app_error", err.Id)
+
+	textTooLong := attachment
+	textTooLong.Text += "a"
+	err = textTooLong.IsValid()
+	require.NotNil(t, err)
+	assert.Equal(t, "model.slack_attachment.is_valid.text.app_error", err.Id)
+}
@@ -27,6 +27,28 @@ type Status struct {
 	ActiveChannel  string `json:"active_channel,omitempty" db:"-"`
 }
 
+type StatusList []*Status
+
+func (o *Status) IsOnline() bool {
+	return o.Status == STATUS_ONLINE
+}
+
+func (o *Status) IsAway() bool {
+	return o.Status == STATUS_AWAY
+}
+
+// CountByStatus returns the number of statuses in the list for each status value.
+func (l StatusList) CountByStatus() map[string]int {
+	counts := make(map[string]int)
+	for _, s := range l {
+		if s == nil {
+			continue
+		}
+		counts[s.Status]++
+	}
+	return counts
+}
+
 func (o *Status) ToJson() string {
 	tempChannelId := o.ActiveChannel
 	o.ActiveChannel = ""
@@ -77,3 +77,31 @@ func TestStatusListFromJson(t *testing.T) {
 		t.Fatal("UserId should be equal")
 	}
 }
+
+func TestStatusIsOnlineIsAway(t *testing.T) {
+	assert.True(t, (&Status{Status: STATUS_ONLINE}).IsOnline())
+	assert.False(t, (&Status{Status: STATUS_ONLINE}).IsAway())
+	assert.True(t, (&Status{Status: STATUS_AWAY}).IsAway())
+	assert.False(t, (&Status{Status: STATUS_AWAY}).IsOnline())
+	assert.False(t, (&Status{Status: STATUS_DND}).IsOnline())
+	assert.False(t, (&Status{Status: STATUS_OFFLINE}).IsAway())
+}
+
+func TestStatusListCountByStatus(t *testing.T) {
+	assert.Equal(t, map[string]int{}, StatusList{}.CountByStatus())
+	assert.Equal(t, map[string]int{}, StatusList(nil).CountByStatus())
+
+	list := StatusList{
+		{UserId: NewId(), Status: STATUS_ONLINE},
+		{UserId: NewId(), Status: STATUS_ONLINE},
+		{UserId: NewId(), Status: STATUS_AWAY},
+		{UserId: NewId(), Status: STATUS_DND},
+		nil,
+	}
+
+	assert.Equal(t, map[string]int{
+		STATUS_ONLINE: 2,
+		STATUS_AWAY:   1,
+		STATUS_DND:    1,
+	}, list.CountByStatus())
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"unicode"
 	"unicode/utf8"
 )
 
@@ -21,6 +22,7 @@ const (
 	TEAM_DESCRIPTION_MAX_LENGTH     = 255
 	TEAM_DISPLAY_NAME_MAX_RUNES     = 64
 	TEAM_EMAIL_MAX_LENGTH           = 128
+	TEAM_INVITE_ID_MAX_LENGTH       = 32
 	TEAM_NAME_MAX_LENGTH            = 64
 	TEAM_NAME_MIN_LENGTH            = 2
 )
@@ -43,6 +45,8 @@ type Team struct {
 	SchemeId           *string `json:"scheme_id"`
 }
 
+type TeamList []*Team
+
 type TeamPatch struct {
 	DisplayName     *string `json:"display_name"`
 	Description     *string `json:"description"`
@@ -80,6 +84,31 @@ func (o *Invites) ToJson() string {
 	return string(b)
 }
 
+// Clone returns a copy of the team that shares no references with the original, so that it can
+// be modified without affecting cached copies.
+func (o *Team) Clone() *Team {
+	copy := *o
+	if o.SchemeId != nil {
+		copy.SchemeId = NewString(*o.SchemeId)
+	}
+	return &copy
+}
+
+// Clone returns a copy of the list with each team cloned.
+func (o TeamList) Clone() TeamList {
+	if o == nil {
+		return nil
+	}
+
+	copy := make(TeamList, len(o))
+	for i, team := range o {
+		if team != nil {
+			copy[i] = team.Clone()
+		}
+	}
+	return copy
+}
+
 func (o *Team) ToJson() string {
 	b, _ := json.Marshal(o)
 	return string(b)
@@ -171,6 +200,10 @@ func (o *Team) IsValid() *AppError {
 		return NewAppError("Team.IsValid", "model.team.is_valid.domains.app_error", nil, "id="+o.Id, http.StatusBadRequest)
 	}
 
+	if len(o.InviteId) > TEAM_INVITE_ID_MAX_LENGTH {
+		return NewAppError("Team.IsValid", "model.team.is_valid.invite_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -181,14 +214,46 @@ func (o *Team) PreSave() {
 
 	o.CreateAt = GetMillis()
 	o.UpdateAt = o.CreateAt
+	o.AllowedDomains = strings.ToLower(o.AllowedDomains)
 
 	if len(o.InviteId) == 0 {
-		o.InviteId = NewId()
+		o.GenerateInviteId()
 	}
 }
 
+// GenerateInviteId replaces the team's invite id with a new random one.
+func (o *Team) GenerateInviteId() {
+	o.InviteId = NewId()
+}
+
+// InviteURL returns the link through which users can join the team using its invite id.
+func (o *Team) InviteURL(siteURL string) string {
+	return strings.TrimRight(siteURL, "/") + "/signup_user_complete/?id=" + o.InviteId
+}
+
 func (o *Team) PreUpdate() {
 	o.UpdateAt = GetMillis()
+	o.AllowedDomains = strings.ToLower(o.AllowedDomains)
+}
+
+var validTeamAllowedDomain = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*$`)
+
+// IsValidTeamAllowedDomains checks that every entry of a team's comma or space separated list of
+// allowed domains is a bare domain name such as example.com. This isn't part of Team.IsValid, so
+// that teams saved before the check was added can still be updated, and should be called whenever
+// AllowedDomains is set or changed.
+func IsValidTeamAllowedDomains(domains string) *AppError {
+	entries := strings.FieldsFunc(strings.ToLower(domains), func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+
+	for _, entry := range entries {
+		if !validTeamAllowedDomain.MatchString(entry) {
+			return NewAppError("Team.IsValidAllowedDomains", "model.team.is_valid.allowed_domain.app_error", map[string]interface{}{"Domain": entry}, "", http.StatusBadRequest)
+		}
+	}
+
+	return nil
 }
 
 func IsReservedTeamName(s string) bool {
@@ -275,6 +340,33 @@ func (t *Team) Patch(patch *TeamPatch) {
 	}
 }
 
+// IsValid validates the fields that are set on the patch using the same rules as Team.IsValid.
+func (t *TeamPatch) IsValid() *AppError {
+	if t.DisplayName != nil && (utf8.RuneCountInString(*t.DisplayName) == 0 || utf8.RuneCountInString(*t.DisplayName) > TEAM_DISPLAY_NAME_MAX_RUNES) {
+		return NewAppError("TeamPatch.IsValid", "model.team.is_valid.name.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if t.Description != nil && len(*t.Description) > TEAM_DESCRIPTION_MAX_LENGTH {
+		return NewAppError("TeamPatch.IsValid", "model.team.is_valid.description.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if t.CompanyName != nil && len(*t.CompanyName) > TEAM_COMPANY_NAME_MAX_LENGTH {
+		return NewAppError("TeamPatch.IsValid", "model.team.is_valid.company.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if t.AllowedDomains != nil && len(*t.AllowedDomains) > TEAM_ALLOWED_DOMAINS_MAX_LENGTH {
+		return NewAppError("TeamPatch.IsValid", "model.team.is_valid.domains.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if t.AllowedDomains != nil {
+		if err := IsValidTeamAllowedDomains(*t.AllowedDomains); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (t *TeamPatch) ToJson() string {
 	b, err := json.Marshal(t)
 	if err != nil {
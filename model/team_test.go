@@ -6,6 +6,8 @@ package model
 import (
 	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestTeamJson(t *testing.T) {
@@ -18,6 +20,48 @@ func TestTeamJson(t *testing.T) {
 	}
 }
 
+func TestTeamClone(t *testing.T) {
+	o := &Team{Id: NewId(), DisplayName: "display name", AllowedDomains: "example.com", SchemeId: NewString(NewId())}
+	schemeId := *o.SchemeId
+
+	c := o.Clone()
+	assert.Equal(t, o, c)
+	assert.False(t, o == c)
+
+	c.DisplayName = "changed"
+	c.AllowedDomains = "changed.com"
+	*c.SchemeId = NewId()
+
+	assert.Equal(t, "display name", o.DisplayName)
+	assert.Equal(t, "example.com", o.AllowedDomains)
+	assert.Equal(t, schemeId, *o.SchemeId)
+
+	assert.Nil(t, (&Team{Id: NewId()}).Clone().SchemeId)
+}
+
+func TestTeamListClone(t *testing.T) {
+	o := TeamList{
+		{Id: NewId(), DisplayName: "team1", SchemeId: NewString(NewId())},
+		nil,
+		{Id: NewId(), DisplayName: "team2"},
+	}
+
+	c := o.Clone()
+	assert.Equal(t, o, c)
+
+	c[0].DisplayName = "changed"
+	*c[0].SchemeId = "changed"
+	c[2] = &Team{Id: NewId()}
+
+	assert.Equal(t, "team1", o[0].DisplayName)
+	assert.NotEqual(t, "changed", *o[0].SchemeId)
+	assert.Equal(t, "team2", o[2].DisplayName)
+	assert.Nil(t, c[1])
+
+	assert.Nil(t, TeamList(nil).Clone())
+	assert.Equal(t, TeamList{}, TeamList{}.Clone())
+}
+
 func TestTeamIsValid(t *testing.T) {
 	o := Team{}
 
@@ -62,6 +106,166 @@ func TestTeamIsValid(t *testing.T) {
 	if err := o.IsValid(); err != nil {
 		t.Fatal(err)
 	}
+
+	o.InviteId = strings.Repeat("a", TEAM_INVITE_ID_MAX_LENGTH+1)
+	if err := o.IsValid(); err == nil {
+		t.Fatal("should be invalid")
+	}
+
+	o.GenerateInviteId()
+	if err := o.IsValid(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIsValidTeamAllowedDomains(t *testing.T) {
+	for _, tc := range []struct {
+		AllowedDomains string
+		Valid          bool
+	}{
+		{"", true},
+		{"example.com", true},
+		{"Example.COM", true},
+		{"example.com, corp.example.org mattermost-test.com", true},
+		{"simulator.amazonses.com,dockerhost", true},
+		{"@example.com", false},
+		{"example.com @corp.example.com", false},
+		{"http://example.com", false},
+		{"example.com/path", false},
+		{"example.com:8065", false},
+		{"user@example.com", false},
+		{"@", false},
+		{"-example.com", false},
+		{"example..com", false},
+		{"example.com, bad_domain.com", false},
+	} {
+		t.Run(tc.AllowedDomains, func(t *testing.T) {
+			err := IsValidTeamAllowedDomains(tc.AllowedDomains)
+			patchErr := (&TeamPatch{AllowedDomains: NewString(tc.AllowedDomains)}).IsValid()
+			if tc.Valid {
+				assert.Nil(t, err)
+				assert.Nil(t, patchErr)
+			} else {
+				if assert.NotNil(t, err) {
+					assert.Equal(t, "model.team.is_valid.allowed_domain.app_error", err.Id)
+				}
+				if assert.NotNil(t, patchErr) {
+					assert.Equal(t, "model.team.is_valid.allowed_domain.app_error", patchErr.Id)
+				}
+			}
+		})
+	}
+}
+
+func TestTeamIsValidLegacyAllowedDomains(t *testing.T) {
+	// Teams saved before allowed domains were validated must still be valid so that they can be
+	// updated.
+	o := Team{
+		Id:             NewId(),
+		CreateAt:       GetMillis(),
+		UpdateAt:       GetMillis(),
+		DisplayName:    "display name",
+		Name:           "zzzzz",
+		Type:           TEAM_OPEN,
+		AllowedDomains: "@example.com, bad_domain.com",
+	}
+
+	assert.Nil(t, o.IsValid())
+}
+
+func TestTeamPreSaveNormalizesAllowedDomains(t *testing.T) {
+	o := Team{AllowedDomains: "Example.COM, corp.example.org"}
+	o.PreSave()
+	assert.Equal(t, "example.com, corp.example.org", o.AllowedDomains)
+
+	o.AllowedDomains = "MATTERMOST.com"
+	o.PreUpdate()
+	assert.Equal(t, "mattermost.com", o.AllowedDomains)
+}
+
+func TestTeamGenerateInviteId(t *testing.T) {
+	o := Team{}
+	o.GenerateInviteId()
+	first := o.InviteId
+
+	o.GenerateInviteId()
+	assert.Len(t, first, 26)
+	assert.Len(t, o.InviteId, 26)
+	assert.NotEqual(t, first, o.InviteId)
+}
+
+func TestTeamInviteURL(t *testing.T) {
+	o := Team{InviteId: "invite"}
+
+	assert.Equal(t, "http://example.com/signup_user_complete/?id=invite", o.InviteURL("http://example.com"))
+	assert.Equal(t, "http://example.com/signup_user_complete/?id=invite", o.InviteURL("http://example.com/"))
+	assert.Equal(t, "http://example.com/sub/signup_user_complete/?id=invite", o.InviteURL("http://example.com/sub//"))
+}
+
+func TestTeamPatch(t *testing.T) {
+	p := &TeamPatch{
+		DisplayName:     NewString(NewId()),
+		Description:     NewString(NewId()),
+		CompanyName:     NewString(NewId()),
+		AllowedDomains:  NewString(NewId()),
+		InviteId:        NewString(NewId()),
+		AllowOpenInvite: NewBool(true),
+	}
+
+	o := Team{Id: NewId(), Name: "zzzzz", Email: "test@example.com"}
+	o.Patch(p)
+
+	assert.Equal(t, *p.DisplayName, o.DisplayName)
+	assert.Equal(t, *p.Description, o.Description)
+	assert.Equal(t, *p.CompanyName, o.CompanyName)
+	assert.Equal(t, *p.AllowedDomains, o.AllowedDomains)
+	assert.Equal(t, *p.InviteId, o.InviteId)
+	assert.Equal(t, *p.AllowOpenInvite, o.AllowOpenInvite)
+	assert.Equal(t, "zzzzz", o.Name)
+	assert.Equal(t, "test@example.com", o.Email)
+
+	o2 := o
+	o2.Patch(&TeamPatch{Description: NewString("new description")})
+
+	assert.Equal(t, "new description", o2.Description)
+	assert.Equal(t, o.DisplayName, o2.DisplayName)
+	assert.Equal(t, o.CompanyName, o2.CompanyName)
+	assert.Equal(t, o.AllowedDomains, o2.AllowedDomains)
+	assert.Equal(t, o.InviteId, o2.InviteId)
+	assert.Equal(t, o.AllowOpenInvite, o2.AllowOpenInvite)
+}
+
+func TestTeamPatchIsValid(t *testing.T) {
+	assert.Nil(t, (&TeamPatch{}).IsValid())
+	assert.Nil(t, (&TeamPatch{DisplayName: NewString("name"), Description: NewString("")}).IsValid())
+
+	if err := (&TeamPatch{DisplayName: NewString("")}).IsValid(); assert.NotNil(t, err) {
+		assert.Equal(t, "model.team.is_valid.name.app_error", err.Id)
+	}
+
+	if err := (&TeamPatch{DisplayName: NewString(strings.Repeat("a", TEAM_DISPLAY_NAME_MAX_RUNES+1))}).IsValid(); assert.NotNil(t, err) {
+		assert.Equal(t, "model.team.is_valid.name.app_error", err.Id)
+	}
+
+	if err := (&TeamPatch{Description: NewString(strings.Repeat("a", TEAM_DESCRIPTION_MAX_LENGTH+1))}).IsValid(); assert.NotNil(t, err) {
+		assert.Equal(t, "model.team.is_valid.description.app_error", err.Id)
+	}
+
+	if err := (&TeamPatch{CompanyName: NewString(strings.Repeat("a", TEAM_COMPANY_NAME_MAX_LENGTH+1))}).IsValid(); assert.NotNil(t, err) {
+		assert.Equal(t, "model.team.is_valid.company.app_error", err.Id)
+	}
+
+	if err := (&TeamPatch{AllowedDomains: NewString(strings.Repeat("a", TEAM_ALLOWED_DOMAINS_MAX_LENGTH+1))}).IsValid(); assert.NotNil(t, err) {
+		assert.Equal(t, "model.team.is_valid.domains.app_error", err.Id)
+	}
+}
+
+func TestTeamPatchJson(t *testing.T) {
+	p := &TeamPatch{DisplayName: NewString(NewId()), AllowOpenInvite: NewBool(false)}
+	rp := TeamPatchFromJson(strings.NewReader(p.ToJson()))
+
+	assert.Equal(t, p, rp)
+	assert.Nil(t, TeamPatchFromJson(strings.NewReader("junk")))
 }
 
 func TestTeamPreSave(t *testing.T) {
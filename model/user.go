@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"golang.org/x/crypto/bcrypt"
@@ -173,9 +174,37 @@ func (u *User) IsValid() *AppError {
 		return InvalidUserError("password_limit", u.Id)
 	}
 
+	if !isValidTimezone(u.Timezone) {
+		return InvalidUserError("timezone", u.Id)
+	}
+
 	return nil
 }
 
+// isValidTimezone checks that the timezone only holds the keys set by DefaultUserTimezone, that
+// useAutomaticTimezone is a boolean and that any timezone names it holds can be loaded. Automatic
+// timezones may be disabled without a manual timezone being set, as older clients saved.
+func isValidTimezone(timezone StringMap) bool {
+	for key, value := range timezone {
+		switch key {
+		case "useAutomaticTimezone":
+			if value != "true" && value != "false" {
+				return false
+			}
+		case "automaticTimezone", "manualTimezone":
+			if value != "" {
+				if _, err := time.LoadLocation(value); err != nil {
+					return false
+				}
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
 func InvalidUserError(fieldName string, userId string) *AppError {
 	id := fmt.Sprintf("model.user.is_valid.%s.app_error", fieldName)
 	details := ""
@@ -323,7 +352,7 @@ func (u *User) Patch(patch *UserPatch) {
 	}
 
 	if patch.NotifyProps != nil {
-		u.NotifyProps = patch.NotifyProps
+		u.PatchNotifyProps(patch.NotifyProps)
 	}
 
 	if patch.Locale != nil {
@@ -331,8 +360,27 @@ func (u *User) Patch(patch *UserPatch) {
 	}
 
 	if patch.Timezone != nil {
-		u.Timezone = patch.Timezone
+		if u.Timezone == nil {
+			u.Timezone = make(StringMap, len(patch.Timezone))
+		}
+		for key, value := range patch.Timezone {
+			u.Timezone[key] = value
+		}
+	}
+}
+
+// PatchNotifyProps merges partial into the user's notify props, so that keys missing from partial
+// keep their current values. The merged props are stored in a new map, leaving any map shared
+// with another copy of the user untouched.
+func (u *User) PatchNotifyProps(partial StringMap) {
+	notifyProps := make(StringMap, len(u.NotifyProps)+len(partial))
+	for key, value := range u.NotifyProps {
+		notifyProps[key] = value
+	}
+	for key, value := range partial {
+		notifyProps[key] = value
 	}
+	u.NotifyProps = notifyProps
 }
 
 // ToJson convert a User to a json string
@@ -577,6 +625,8 @@ var restrictedUsernames = []string{
 	"system",
 }
 
+// IsValidUsername returns true if s is between USER_NAME_MIN_LENGTH and USER_NAME_MAX_LENGTH
+// characters long, inclusive, uses only lowercase letters, numbers, '.', '-' and '_', and isn't restricted.
 func IsValidUsername(s string) bool {
 	if len(s) < USER_NAME_MIN_LENGTH || len(s) > USER_NAME_MAX_LENGTH {
 		return false
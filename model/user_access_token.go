@@ -9,6 +9,10 @@ import (
 	"net/http"
 )
 
+const (
+	USER_ACCESS_TOKEN_DESCRIPTION_MAX_LENGTH = 255
+)
+
 type UserAccessToken struct {
 	Id          string `json:"id"`
 	Token       string `json:"token,omitempty"`
@@ -17,6 +21,8 @@ type UserAccessToken struct {
 	IsActive    bool   `json:"is_active"`
 }
 
+type UserAccessTokenList []*UserAccessToken
+
 func (t *UserAccessToken) IsValid() *AppError {
 	if len(t.Id) != 26 {
 		return NewAppError("UserAccessToken.IsValid", "model.user_access_token.is_valid.id.app_error", nil, "", http.StatusBadRequest)
@@ -30,7 +36,7 @@ func (t *UserAccessToken) IsValid() *AppError {
 		return NewAppError("UserAccessToken.IsValid", "model.user_access_token.is_valid.user_id.app_error", nil, "", http.StatusBadRequest)
 	}
 
-	if len(t.Description) > 255 {
+	if len(t.Description) > USER_ACCESS_TOKEN_DESCRIPTION_MAX_LENGTH {
 		return NewAppError("UserAccessToken.IsValid", "model.user_access_token.is_valid.description.app_error", nil, "", http.StatusBadRequest)
 	}
 
@@ -42,6 +48,17 @@ func (t *UserAccessToken) PreSave() {
 	t.IsActive = true
 }
 
+// Sanitize removes the token's secret so that it can be returned to clients.
+func (t *UserAccessToken) Sanitize() {
+	t.Token = ""
+}
+
+func (l UserAccessTokenList) Sanitize() {
+	for _, t := range l {
+		t.Sanitize()
+	}
+}
+
 func (t *UserAccessToken) ToJson() string {
 	b, _ := json.Marshal(t)
 	return string(b)
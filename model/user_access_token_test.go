@@ -56,3 +56,36 @@ func TestUserAccessTokenIsValid(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestUserAccessTokenSanitize(t *testing.T) {
+	token := UserAccessToken{Id: NewId(), Token: NewId(), UserId: NewId(), Description: "description"}
+	token.Sanitize()
+
+	if token.Token != "" {
+		t.Fatal("token should have been cleared")
+	}
+
+	if token.Id == "" || token.UserId == "" || token.Description != "description" {
+		t.Fatal("other fields should not have been cleared")
+	}
+
+	if strings.Contains(token.ToJson(), `"token"`) {
+		t.Fatal("token should not be serialized")
+	}
+}
+
+func TestUserAccessTokenListSanitize(t *testing.T) {
+	list := UserAccessTokenList{
+		{Id: NewId(), Token: NewId()},
+		{Id: NewId(), Token: NewId()},
+	}
+	list.Sanitize()
+
+	for _, token := range list {
+		if token.Token != "" {
+			t.Fatal("token should have been cleared")
+		}
+	}
+
+	UserAccessTokenList(nil).Sanitize()
+}
@@ -179,6 +179,126 @@ func TestUserIsValid(t *testing.T) {
 	if err := user.IsValid(); !HasExpectedUserIsValidError(err, "position", user.Id) {
 		t.Fatal(err)
 	}
+
+	user.Position = ""
+	user.Timezone = StringMap{"useAutomaticTimezone": "yes"}
+	if err := user.IsValid(); !HasExpectedUserIsValidError(err, "timezone", user.Id) {
+		t.Fatal(err)
+	}
+
+	// Older clients could disable automatic timezones without setting a manual one.
+	user.Timezone = StringMap{"useAutomaticTimezone": "false", "automaticTimezone": "America/New_York", "manualTimezone": ""}
+	if err := user.IsValid(); err != nil {
+		t.Fatal(err)
+	}
+
+	user.Timezone["manualTimezone"] = "America/Toronto"
+	if err := user.IsValid(); err != nil {
+		t.Fatal(err)
+	}
+
+	user.Timezone["manualTimezone"] = "Nowhere/Island"
+	if err := user.IsValid(); !HasExpectedUserIsValidError(err, "timezone", user.Id) {
+		t.Fatal(err)
+	}
+
+	user.Timezone = StringMap{"useAutomaticTimezone": "true", "automaticTimezone": "Nowhere/Island"}
+	if err := user.IsValid(); !HasExpectedUserIsValidError(err, "timezone", user.Id) {
+		t.Fatal(err)
+	}
+
+	user.Timezone = StringMap{"useAutomaticTimezone": "true", "automaticTimezone": "America/New_York", "otherTimezone": "UTC"}
+	if err := user.IsValid(); !HasExpectedUserIsValidError(err, "timezone", user.Id) {
+		t.Fatal(err)
+	}
+
+	user.Timezone = DefaultUserTimezone()
+	if err := user.IsValid(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUserPatchTimezone(t *testing.T) {
+	user := User{
+		Nickname: "nickname",
+		Timezone: StringMap{
+			"useAutomaticTimezone": "true",
+			"automaticTimezone":    "America/New_York",
+			"manualTimezone":       "",
+		},
+	}
+
+	user.Patch(&UserPatch{
+		Timezone: StringMap{
+			"useAutomaticTimezone": "false",
+			"manualTimezone":       "America/Toronto",
+		},
+	})
+
+	assert.Equal(t, "nickname", user.Nickname)
+	assert.Equal(t, StringMap{
+		"useAutomaticTimezone": "false",
+		"automaticTimezone":    "America/New_York",
+		"manualTimezone":       "America/Toronto",
+	}, user.Timezone)
+	assert.Equal(t, "America/Toronto", user.GetPreferredTimezone())
+
+	user = User{}
+	user.Patch(&UserPatch{Timezone: StringMap{"automaticTimezone": "America/New_York"}})
+	assert.Equal(t, StringMap{"automaticTimezone": "America/New_York"}, user.Timezone)
+}
+
+func TestUserPatchLegacyTimezone(t *testing.T) {
+	user := User{
+		Id:       NewId(),
+		Roles:    "system_user",
+		CreateAt: GetMillis(),
+		UpdateAt: GetMillis(),
+		Username: NewId(),
+		Email:    "user@example.com",
+		Password: "password",
+		Timezone: StringMap{
+			"useAutomaticTimezone": "false",
+			"automaticTimezone":    "America/New_York",
+			"manualTimezone":       "",
+		},
+	}
+
+	user.Patch(&UserPatch{Nickname: NewString("nickname")})
+
+	assert.Nil(t, user.IsValid())
+	assert.Equal(t, "nickname", user.Nickname)
+}
+
+func TestUserPatchNotifyProps(t *testing.T) {
+	notifyProps := StringMap{
+		DESKTOP_NOTIFY_PROP: USER_NOTIFY_ALL,
+		EMAIL_NOTIFY_PROP:   "true",
+		PUSH_NOTIFY_PROP:    USER_NOTIFY_MENTION,
+	}
+	user := User{Nickname: "nickname", NotifyProps: notifyProps}
+
+	user.Patch(&UserPatch{NotifyProps: StringMap{EMAIL_NOTIFY_PROP: "false"}})
+
+	assert.Equal(t, "nickname", user.Nickname)
+	assert.Equal(t, StringMap{
+		DESKTOP_NOTIFY_PROP: USER_NOTIFY_ALL,
+		EMAIL_NOTIFY_PROP:   "false",
+		PUSH_NOTIFY_PROP:    USER_NOTIFY_MENTION,
+	}, user.NotifyProps)
+	assert.Equal(t, "true", notifyProps[EMAIL_NOTIFY_PROP], "original map should not be modified")
+
+	user.PatchNotifyProps(StringMap{COMMENTS_NOTIFY_PROP: "any"})
+	assert.Equal(t, "any", user.NotifyProps[COMMENTS_NOTIFY_PROP])
+	assert.Equal(t, USER_NOTIFY_ALL, user.NotifyProps[DESKTOP_NOTIFY_PROP])
+	assert.Len(t, user.NotifyProps, 4)
+
+	user.Patch(&UserPatch{Nickname: NewString("other")})
+	assert.Len(t, user.NotifyProps, 4)
+
+	user = User{}
+	user.PatchNotifyProps(StringMap{PUSH_NOTIFY_PROP: USER_NOTIFY_NONE})
+	assert.Equal(t, StringMap{PUSH_NOTIFY_PROP: USER_NOTIFY_NONE}, user.NotifyProps)
 }
 
 func HasExpectedUserIsValidError(err *AppError, fieldName string, userId string) bool {
@@ -272,6 +392,10 @@ var usernames = []struct {
 	{"spin*punch", false},
 	{"all", false},
 	{"system", false},
+	{strings.Repeat("a", USER_NAME_MAX_LENGTH-1), true},
+	{strings.Repeat("a", USER_NAME_MAX_LENGTH), true},
+	{strings.Repeat("a", USER_NAME_MAX_LENGTH+1), false},
+	{"", false},
 }
 
 func TestValidUsername(t *testing.T) {
@@ -51,6 +51,7 @@ type AppError struct {
 	Where         string `json:"-"`                     // The function where it happened in the form of Struct.Func
 	IsOAuth       bool   `json:"is_oauth,omitempty"`    // Whether the error is OAuth specific
 	params        map[string]interface{}
+	wrapped       error
 }
 
 func (er *AppError) Error() string {
@@ -78,6 +79,31 @@ func (er *AppError) SystemMessage(T goi18n.TranslateFunc) string {
 	}
 }
 
+// WrapError records err as the underlying cause of the AppError. If no DetailedError has been set,
+// the message of err is used for it.
+func (er *AppError) WrapError(err error) *AppError {
+	er.wrapped = err
+	if err != nil && er.DetailedError == "" {
+		er.DetailedError = err.Error()
+	}
+	return er
+}
+
+// Unwrap returns the error passed to WrapError, if any.
+func (er *AppError) Unwrap() error {
+	return er.wrapped
+}
+
+// Is reports whether target is an AppError with the same Id, so that errors.Is can match on the
+// translation key regardless of the message or details.
+func (er *AppError) Is(target error) bool {
+	t, ok := target.(*AppError)
+	if !ok || er == nil || t == nil {
+		return false
+	}
+	return er.Id == t.Id
+}
+
 func (er *AppError) ToJson() string {
 	b, _ := json.Marshal(er)
 	return string(b)
@@ -221,6 +247,36 @@ func MapBoolFromJson(data io.Reader) map[string]bool {
 	}
 }
 
+// ToJson converts the map to a json string, encoding a nil map as an empty object.
+func (m StringMap) ToJson() string {
+	if m == nil {
+		return "{}"
+	}
+
+	b, _ := json.Marshal(map[string]string(m))
+	return string(b)
+}
+
+// MarshalJSON ensures that StringMap fields of model types are never encoded as null.
+func (m StringMap) MarshalJSON() ([]byte, error) {
+	return []byte(m.ToJson()), nil
+}
+
+// ToJson converts the array to a json string, encoding a nil array as an empty array.
+func (a StringArray) ToJson() string {
+	if a == nil {
+		return "[]"
+	}
+
+	b, _ := json.Marshal([]string(a))
+	return string(b)
+}
+
+// MarshalJSON ensures that StringArray fields of model types are never encoded as null.
+func (a StringArray) MarshalJSON() ([]byte, error) {
+	return []byte(a.ToJson()), nil
+}
+
 func ArrayToJson(objmap []string) string {
 	b, _ := json.Marshal(objmap)
 	return string(b)
@@ -4,6 +4,7 @@
 package model
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -76,6 +77,32 @@ func TestAppError(t *testing.T) {
 	t.Log(err.Error())
 }
 
+func TestAppErrorWrapError(t *testing.T) {
+	cause := errors.New("connection refused")
+
+	err := NewAppError("TestAppErrorWrapError", "message", nil, "", http.StatusInternalServerError).WrapError(cause)
+	assert.Equal(t, cause, err.Unwrap())
+	assert.Equal(t, "connection refused", err.DetailedError)
+
+	err = NewAppError("TestAppErrorWrapError", "message", nil, "details", http.StatusInternalServerError).WrapError(cause)
+	assert.Equal(t, cause, err.Unwrap())
+	assert.Equal(t, "details", err.DetailedError)
+
+	err = NewAppError("TestAppErrorWrapError", "message", nil, "", http.StatusInternalServerError)
+	assert.Nil(t, err.Unwrap())
+}
+
+func TestAppErrorIs(t *testing.T) {
+	err := NewAppError("User.IsValid", "model.user.is_valid.username.app_error", nil, "user_id=abc", http.StatusBadRequest)
+
+	assert.True(t, errors.Is(err, &AppError{Id: "model.user.is_valid.username.app_error"}))
+	assert.False(t, errors.Is(err, &AppError{Id: "model.user.is_valid.email.app_error"}))
+	assert.False(t, errors.Is(err, errors.New("model.user.is_valid.username.app_error")))
+
+	wrapped := fmt.Errorf("saving user: %w", err)
+	assert.True(t, errors.Is(wrapped, &AppError{Id: "model.user.is_valid.username.app_error"}))
+}
+
 func TestAppErrorJunk(t *testing.T) {
 	rerr := AppErrorFromJson(strings.NewReader("<html><body>This is a broken test</body></html>"))
 	require.Equal(t, "body: <html><body>This is a broken test</body></html>", rerr.DetailedError)
@@ -110,6 +137,40 @@ func TestMapJson(t *testing.T) {
 	}
 }
 
+func TestStringMapToJson(t *testing.T) {
+	var m StringMap
+	assert.Equal(t, "{}", m.ToJson())
+	assert.Equal(t, map[string]string{}, MapFromJson(strings.NewReader(m.ToJson())))
+
+	m = StringMap{"id": "test_id"}
+	assert.Equal(t, `{"id":"test_id"}`, m.ToJson())
+	assert.Equal(t, map[string]string(m), MapFromJson(strings.NewReader(m.ToJson())))
+}
+
+func TestStringArrayToJson(t *testing.T) {
+	var a StringArray
+	assert.Equal(t, "[]", a.ToJson())
+	assert.Equal(t, []string{}, ArrayFromJson(strings.NewReader(a.ToJson())))
+
+	a = StringArray{"a", "b"}
+	assert.Equal(t, `["a","b"]`, a.ToJson())
+	assert.Equal(t, []string(a), ArrayFromJson(strings.NewReader(a.ToJson())))
+}
+
+func TestStringMapAndArrayFieldsMarshalEmpty(t *testing.T) {
+	user := User{Id: NewId()}
+	userJson := user.ToJson()
+	assert.Contains(t, userJson, `"timezone":{}`)
+	assert.NotContains(t, userJson, `"props"`)
+	assert.Equal(t, StringMap{}, UserFromJson(strings.NewReader(userJson)).Timezone)
+
+	hook := OutgoingWebhook{Id: NewId()}
+	hookJson := hook.ToJson()
+	assert.Contains(t, hookJson, `"trigger_words":[]`)
+	assert.Contains(t, hookJson, `"callback_urls":[]`)
+	assert.Equal(t, StringArray{}, OutgoingWebhookFromJson(strings.NewReader(hookJson)).TriggerWords)
+}
+
 func TestIsValidEmail(t *testing.T) {
 	for _, testCase := range []struct {
 		Input    string
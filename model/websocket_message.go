@@ -99,6 +99,26 @@ func (m *WebSocketEvent) Add(key string, value interface{}) {
 	m.Data[key] = value
 }
 
+// GetBroadcast returns the event's broadcast, or an empty broadcast if none is set.
+func (m *WebSocketEvent) GetBroadcast() *WebsocketBroadcast {
+	if m.Broadcast == nil {
+		return &WebsocketBroadcast{}
+	}
+	return m.Broadcast
+}
+
+// GetData returns the value stored under key and whether it was present.
+func (m *WebSocketEvent) GetData(key string) (interface{}, bool) {
+	value, ok := m.Data[key]
+	return value, ok
+}
+
+// GetStringData returns the value stored under key if it is a string, or "" otherwise.
+func (m *WebSocketEvent) GetStringData(key string) string {
+	value, _ := m.Data[key].(string)
+	return value
+}
+
 func NewWebSocketEvent(event, teamId, channelId, userId string, omitUsers map[string]bool) *WebSocketEvent {
 	return &WebSocketEvent{Event: event, Data: make(map[string]interface{}),
 		Broadcast: &WebsocketBroadcast{TeamId: teamId, ChannelId: channelId, UserId: userId, OmitUsers: omitUsers}}
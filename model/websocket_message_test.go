@@ -102,3 +102,30 @@ func BenchmarkWebSocketEvent_ToJson(b *testing.B) {
 		}
 	})
 }
+
+func TestWebSocketEventGetters(t *testing.T) {
+	m := NewWebSocketEvent("some_event", "team_id", "channel_id", "user_id", nil)
+	m.Add("string", "value")
+	m.Add("number", 10)
+
+	assert.Equal(t, "team_id", m.GetBroadcast().TeamId)
+	assert.Equal(t, "channel_id", m.GetBroadcast().ChannelId)
+
+	value, ok := m.GetData("number")
+	assert.True(t, ok)
+	assert.Equal(t, 10, value)
+
+	value, ok = m.GetData("missing")
+	assert.False(t, ok)
+	assert.Nil(t, value)
+
+	assert.Equal(t, "value", m.GetStringData("string"))
+	assert.Equal(t, "", m.GetStringData("number"))
+	assert.Equal(t, "", m.GetStringData("missing"))
+
+	empty := &WebSocketEvent{}
+	assert.NotNil(t, empty.GetBroadcast())
+	_, ok = empty.GetData("missing")
+	assert.False(t, ok)
+	assert.Equal(t, "", empty.GetStringData("missing"))
+}
@@ -984,11 +984,11 @@ func (me mattermConverter) ToDb(val interface{}) (interface{}, error) {
 
 	switch t := val.(type) {
 	case model.StringMap:
-		return model.MapToJson(t), nil
+		return t.ToJson(), nil
 	case map[string]string:
 		return model.MapToJson(model.StringMap(t)), nil
 	case model.StringArray:
-		return model.ArrayToJson(t), nil
+		return t.ToJson(), nil
 	case model.StringInterface:
 		return model.StringInterfaceToJson(t), nil
 	case map[string]interface{}:
@@ -91,7 +91,7 @@ func testChannelStoreSave(t *testing.T, ss store.Store) {
 	}
 
 	o1.Id = ""
-	o1.Name = "zz" + model.NewId() + "b"
+	o1.Name = model.GetDMNameFromIds(model.NewId(), model.NewId())
 	o1.Type = model.CHANNEL_DIRECT
 	if err := (<-ss.Channel().Save(&o1, -1)).Err; err == nil {
 		t.Fatal("Should not be able to save direct channel")
@@ -104,7 +104,7 @@ func testChannelStoreSaveDirectChannel(t *testing.T, ss store.Store) {
 	o1 := model.Channel{}
 	o1.TeamId = teamId
 	o1.DisplayName = "Name"
-	o1.Name = "zz" + model.NewId() + "b"
+	o1.Name = model.GetDMNameFromIds(model.NewId(), model.NewId())
 	o1.Type = model.CHANNEL_DIRECT
 
 	u1 := &model.User{}
@@ -169,7 +169,7 @@ func testChannelStoreSaveDirectChannel(t *testing.T, ss store.Store) {
 	// Save yourself Direct Message
 	o1.Id = ""
 	o1.DisplayName = "Myself"
-	o1.Name = "zz" + model.NewId() + "b"
+	o1.Name = model.GetDMNameFromIds(model.NewId(), model.NewId())
 	o1.Type = model.CHANNEL_DIRECT
 	if err := (<-ss.Channel().SaveDirectChannel(&o1, &m1, &m1)).Err; err != nil {
 		t.Fatal("couldn't save direct channel", err)
@@ -361,7 +361,7 @@ func testChannelStoreGet(t *testing.T, ss store.Store) {
 	o2 := model.Channel{}
 	o2.TeamId = model.NewId()
 	o2.DisplayName = "Direct Name"
-	o2.Name = "zz" + model.NewId() + "b"
+	o2.Name = model.GetDMNameFromIds(model.NewId(), model.NewId())
 	o2.Type = model.CHANNEL_DIRECT
 
 	m1 := model.ChannelMember{}
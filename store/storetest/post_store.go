@@ -1185,7 +1185,7 @@ func testPostStoreGetFlaggedPostsForTeam(t *testing.T, ss store.Store) {
 
 	c2 := &model.Channel{}
 	c2.DisplayName = "DMChannel1"
-	c2.Name = "zz" + model.NewId() + "b"
+	c2.Name = model.GetDMNameFromIds(model.NewId(), model.NewId())
 	c2.Type = model.CHANNEL_DIRECT
 
 	m1 := &model.ChannelMember{}
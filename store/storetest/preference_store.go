@@ -83,7 +83,7 @@ func testPreferenceGet(t *testing.T, ss store.Store) {
 		},
 		{
 			UserId:   userId,
-			Category: model.NewId(),
+			Category: model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId(),
 			Name:     name,
 		},
 		{
@@ -127,7 +127,7 @@ func testPreferenceGetCategory(t *testing.T, ss store.Store) {
 		// same user/name, different category
 		{
 			UserId:   userId,
-			Category: model.NewId(),
+			Category: model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId(),
 			Name:     name,
 		},
 		// same name/category, different user
@@ -176,7 +176,7 @@ func testPreferenceGetAll(t *testing.T, ss store.Store) {
 		// same user/name, different category
 		{
 			UserId:   userId,
-			Category: model.NewId(),
+			Category: model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId(),
 			Name:     name,
 		},
 		// same name/category, different user
@@ -222,7 +222,7 @@ func testPreferenceDeleteByUser(t *testing.T, ss store.Store) {
 		// same user/name, different category
 		{
 			UserId:   userId,
-			Category: model.NewId(),
+			Category: model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId(),
 			Name:     name,
 		},
 		// same name/category, different user
@@ -263,7 +263,7 @@ func testIsFeatureEnabled(t *testing.T, ss store.Store) {
 		},
 		{
 			UserId:   userId,
-			Category: model.NewId(),
+			Category: model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId(),
 			Name:     store.FEATURE_TOGGLE_PREFIX + feature1,
 			Value:    "false",
 		},
@@ -334,7 +334,7 @@ func testPreferenceDelete(t *testing.T, ss store.Store) {
 }
 
 func testPreferenceDeleteCategory(t *testing.T, ss store.Store) {
-	category := model.NewId()
+	category := model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId()
 	userId := model.NewId()
 
 	preference1 := model.Preference{
@@ -367,7 +367,7 @@ func testPreferenceDeleteCategory(t *testing.T, ss store.Store) {
 }
 
 func testPreferenceDeleteCategoryAndName(t *testing.T, ss store.Store) {
-	category := model.NewId()
+	category := model.PREFERENCE_CATEGORY_PLUGIN_PREFIX + model.NewId()
 	name := model.NewId()
 	userId := model.NewId()
 	userId2 := model.NewId()
@@ -1245,7 +1245,7 @@ func testUserUnreadCount(t *testing.T, ss store.Store) {
 	c2 := model.Channel{}
 	c2.TeamId = teamId
 	c2.DisplayName = "Unread Direct"
-	c2.Name = "unread-direct-" + model.NewId()
+	c2.Name = model.GetDMNameFromIds(model.NewId(), model.NewId())
 	c2.Type = model.CHANNEL_DIRECT
 
 	u1 := &model.User{}